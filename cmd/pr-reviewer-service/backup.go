@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// runExport пишет полный архив данных в файл или stdout:
+//
+//	pr-reviewer-service export [-o file]
+func runExport(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, args []string) (err error) {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := fs.String("o", "-", "output file, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("create archive file: %w", err)
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		out = f
+	}
+
+	buf := bufio.NewWriter(out)
+	backupService := service.NewBackupService(postgres.NewBackupRepository(pool, logger), postgres.NewTransactor(pool, logger), newAuditService(pool, logger), logger)
+
+	if err := backupService.Export(ctx, buf); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+// runImport восстанавливает архив в пустую БД:
+//
+//	pr-reviewer-service import <file|->
+func runImport(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: import <file|->")
+	}
+
+	var in io.Reader = os.Stdin
+	if path := fs.Arg(0); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open archive file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	backupService := service.NewBackupService(postgres.NewBackupRepository(pool, logger), postgres.NewTransactor(pool, logger), newAuditService(pool, logger), logger)
+
+	result, err := backupService.Restore(ctx, bufio.NewReader(in))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("restored %d teams, %d users, %d role assignments, %d pull requests, %d reviewers, %d activity log entries\n",
+		result.TeamsCount, result.UsersCount, result.UserRolesCount, result.PullRequestsCount, result.ReviewersCount, result.ActivityLogCount)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/importer"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// runBulkImport загружает документ импорта из файла или stdin:
+//
+//	pr-reviewer-service bulk-import [-format json|yaml|csv] [-dry-run] <file|->
+func runBulkImport(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, args []string) error {
+	fs := flag.NewFlagSet("bulk-import", flag.ContinueOnError)
+	format := fs.String("format", "", "document format: json, yaml or csv (detected by file extension if empty)")
+	dryRun := fs.Bool("dry-run", false, "validate and roll back instead of committing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: bulk-import [-format json|yaml|csv] [-dry-run] <file|->")
+	}
+
+	path := fs.Arg(0)
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open import file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	if *format == "" {
+		*format = formatFromExtension(path)
+	}
+
+	batch, err := importer.Parse(in, *format)
+	if err != nil {
+		return err
+	}
+
+	importService := service.NewImportService(postgres.NewImportRepository(pool, logger), postgres.NewTransactor(pool, logger), newAuditService(pool, logger), logger)
+
+	result, err := importService.Import(ctx, batch, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Errors) > 0 {
+		for _, e := range result.Errors {
+			fmt.Fprintf(os.Stderr, "%s row %d %s: %s\n", e.Section, e.Row, e.Field, e.Message)
+		}
+		return fmt.Errorf("import rejected: %d invalid rows", len(result.Errors))
+	}
+
+	mode := "imported"
+	if result.DryRun {
+		mode = "dry run ok, would import"
+	}
+	fmt.Printf("%s %d teams, %d users, %d pull requests, %d reviewers\n",
+		mode, result.TeamsCount, result.UsersCount, result.PullRequestsCount, result.ReviewersCount)
+
+	return nil
+}
+
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return importer.FormatYAML
+	case ".csv":
+		return importer.FormatCSV
+	default:
+		return importer.FormatJSON
+	}
+}
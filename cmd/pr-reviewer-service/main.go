@@ -2,83 +2,186 @@
 // @version         1.0
 // @description     Сервис назначения ревьюеров на Pull Request'ы.
 // @BasePath        /
+//
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 API-токен в формате "Bearer prs_...".
 package main
 
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	app2 "github.com/blumgardt/pr-reviewer-service.git/internal/app"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/logging"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
 
 	_ "github.com/blumgardt/pr-reviewer-service.git/docs"
 )
 
-const (
-	configPath string = "config.toml"
-)
-
 func main() {
-	cfg, err := config.Load(configPath)
+	cfg, args, err := config.Load(os.Args[1:])
 	if err != nil {
-		log.Fatal(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
 
-	ctx := context.Background()
+	// В режиме подкоманды stdout занят результатом (например, архивом export),
+	// поэтому логи уходят в stderr.
+	logOutput := os.Stdout
+	if len(args) > 0 {
+		logOutput = os.Stderr
+	}
+	logger := logging.New(logOutput, cfg.Log)
+	slog.SetDefault(logger)
 
-	connString := fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s",
-		cfg.Postgres.User,
-		cfg.Postgres.Password,
-		cfg.Postgres.Host,
-		cfg.Postgres.Port,
-		cfg.Postgres.Database,
-	)
-	log.Printf("Connecting to DB: %s\n", connString)
+	if err := run(cfg, args, logger); err != nil {
+		logger.Error("fatal error", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
 
-	conn, err := connectWithRetry(ctx, connString)
+func run(cfg *config.Config, args []string, logger *slog.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	tp, err := tracing.New(ctx, cfg.Tracing)
 	if err != nil {
-		log.Fatalf("Unable to connect to database: %v\n", err)
+		return err
 	}
-	defer conn.Close()
+	defer func() {
+		// Контекст ctx к этому моменту уже отменён сигналом — даём экспортёру своё время.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(shutdownCtx); err != nil {
+			logger.Error("tracing shutdown", slog.Any("error", err))
+		}
+	}()
 
-	err = conn.Ping(ctx)
+	poolConfig, err := newPoolConfig(ctx, cfg.Postgres, logger, tp)
 	if err != nil {
-		log.Fatal("Error ping db")
+		return err
+	}
+	logger.Info("connecting to database", slog.Any("postgres", cfg.Postgres))
+
+	conn, err := connectWithRetry(ctx, poolConfig, logger)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer conn.Close()
+
+	if len(args) > 0 {
+		return runCommand(ctx, conn, logger, args[0], args[1:])
+	}
+
+	if err := ensureSchema(ctx, conn, logger, cfg.Migrations.AutoMigrate); err != nil {
+		return err
+	}
+
+	var jwks auth.KeySet
+	if cfg.OIDC.Enabled {
+		client := &http.Client{Transport: tracing.Transport(tp, nil), Timeout: 10 * time.Second}
+		if jwks, err = auth.NewKeySet(cfg.OIDC, client); err != nil {
+			return fmt.Errorf("load oidc keys: %w", err)
+		}
 	}
 
-	logger := log.New(os.Stdout, "[api]", log.Ldate|log.Ltime)
+	app := app2.NewApp(cfg, logger, conn, tp, jwks)
+
+	return app.Run(ctx)
+}
+
+func runCommand(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, name string, args []string) error {
+	// Подкоманды запускает оператор на сервере: права не проверяются, в аудит они пишутся как system.
+	ctx = auth.WithIdentity(ctx, auth.System())
+
+	switch name {
+	case "bulk-import":
+		return runBulkImport(ctx, pool, logger, args)
+	case "export":
+		return runExport(ctx, pool, logger, args)
+	case "import":
+		return runImport(ctx, pool, logger, args)
+	case "migrate":
+		return runMigrate(ctx, pool, args)
+	case "token":
+		return runToken(ctx, pool, logger, args)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
+
+func newAuditService(pool *pgxpool.Pool, logger *slog.Logger) service.AuditService {
+	return service.NewAuditService(postgres.NewAuditRepository(pool), logger)
+}
 
-	app := app2.NewApp(cfg, logger, conn)
+func newPoolConfig(ctx context.Context, pg config.PostgresConfig, logger *slog.Logger, tp trace.TracerProvider) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(pg.ConnString())
+	if err != nil {
+		return nil, fmt.Errorf("parse postgres config: %w", err)
+	}
+
+	if pg.MaxConns > 0 {
+		poolConfig.MaxConns = pg.MaxConns
+	}
+	if pg.MinConns > 0 {
+		poolConfig.MinConns = pg.MinConns
+	}
+	if pg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = pg.MaxConnLifetime
+	}
+	if pg.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = pg.MaxConnIdleTime
+	}
+	if pg.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = fmt.Sprint(pg.StatementTimeout.Milliseconds())
+	}
 
-	if err := app.Start(); err != nil {
-		log.Fatal(err)
+	tracers := []pgx.QueryTracer{tracing.NewQueryTracer(tp)}
+	// SQL-запросы пишутся в лог только на уровне debug; без него логирующий трассировщик
+	// не ставится, чтобы не собирать атрибуты для каждого запроса впустую.
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		tracers = append(tracers, logging.NewQueryTracer(logger))
 	}
+	poolConfig.ConnConfig.Tracer = multitracer.New(tracers...)
 
+	return poolConfig, nil
 }
 
-func connectWithRetry(ctx context.Context, connString string) (*pgxpool.Pool, error) {
+func connectWithRetry(ctx context.Context, poolConfig *pgxpool.Config, logger *slog.Logger) (*pgxpool.Pool, error) {
 	var pool *pgxpool.Pool
 	var err error
 
 	for attempt := 1; attempt <= 10; attempt++ {
-		log.Printf("Connecting to DB (attempt %d): %s", attempt, connString)
+		logger.Debug("connecting to database", slog.Int("attempt", attempt), slog.String("dsn", logging.RedactURL(poolConfig.ConnString())))
 
-		pool, err = pgxpool.New(ctx, connString)
+		pool, err = pgxpool.NewWithConfig(ctx, poolConfig)
 		if err == nil {
 			if pingErr := pool.Ping(ctx); pingErr == nil {
-				log.Println("DB connection established")
+				logger.Info("database connection established")
 				return pool, nil
 			} else {
+				pool.Close()
 				err = pingErr
 			}
 		}
 
-		log.Printf("DB not ready: %v", err)
+		logger.Warn("database not ready", slog.Int("attempt", attempt), slog.Any("error", err))
 		time.Sleep(1 * time.Second)
 	}
 
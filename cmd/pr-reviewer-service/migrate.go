@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/migrate"
+	"github.com/blumgardt/pr-reviewer-service.git/migrations"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// runMigrate управляет схемой БД:
+//
+//	pr-reviewer-service migrate up|down [-steps N]|status
+func runMigrate(ctx context.Context, pool *pgxpool.Pool, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [-steps N]|status")
+	}
+
+	migrator, err := migrate.New(pool, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		n, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migrations\n", n)
+	case "down":
+		fs := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+		steps := fs.Int("steps", 1, "number of migrations to roll back")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		n, err := migrator.Down(ctx, *steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("rolled back %d migrations\n", n)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED AT")
+		for _, st := range statuses {
+			appliedAt := "pending"
+			if st.AppliedAt != nil {
+				appliedAt = st.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(tw, "%04d\t%s\t%s\n", st.Version, st.Name, appliedAt)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+
+	return nil
+}
+
+// ensureSchema применяет миграции при auto_migrate или отказывается
+// запускать сервер, если схема устарела.
+func ensureSchema(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, autoMigrate bool) error {
+	migrator, err := migrate.New(pool, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	if autoMigrate {
+		n, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info("migrations applied", slog.Int("count", n))
+		return nil
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d pending migrations, run `migrate up` or enable migrations.auto_migrate", migrate.ErrOutdated, pending)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tokenUsage = "usage: token create -name NAME [-admin] [-user ID] [-ttl DURATION] | token list | token revoke TOKEN_ID"
+
+// runToken управляет API-токенами напрямую через БД — так выпускается первый
+// администраторский токен, когда обратиться к API ещё нечем:
+//
+//	pr-reviewer-service token create -name bootstrap -admin
+func runToken(ctx context.Context, pool *pgxpool.Pool, logger *slog.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(tokenUsage)
+	}
+
+	tokenService := service.NewTokenService(
+		postgres.NewTokenRepository(pool),
+		postgres.NewRoleRepository(pool),
+		postgres.NewTransactor(pool, logger),
+		newAuditService(pool, logger),
+		logger,
+	)
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("token create", flag.ContinueOnError)
+		name := fs.String("name", "", "token name")
+		isAdmin := fs.Bool("admin", false, "grant administrator access")
+		userID := fs.String("user", "", "user the token acts as")
+		ttl := fs.Duration("ttl", 0, "token lifetime, 0 for no expiry")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		var expiresAt *time.Time
+		if *ttl > 0 {
+			t := time.Now().Add(*ttl)
+			expiresAt = &t
+		}
+
+		token, raw, err := tokenService.Create(ctx, *name, *userID, *isAdmin, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "created token %s; it is shown only once\n", token.ID)
+		fmt.Println(raw)
+	case "list":
+		tokens, err := tokenService.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tNAME\tUSER\tADMIN\tEXPIRES AT\tLAST USED AT\tREVOKED AT")
+		for _, t := range tokens {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\t%s\t%s\n",
+				t.ID, t.Name, t.UserID, t.IsAdmin, formatOptionalTime(t.ExpiresAt), formatOptionalTime(t.LastUsedAt), formatOptionalTime(t.RevokedAt))
+		}
+		return tw.Flush()
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New(tokenUsage)
+		}
+		if _, err := tokenService.Revoke(ctx, args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("revoked token %s\n", args[1])
+	default:
+		return errors.New(tokenUsage)
+	}
+
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -1,17 +1,10 @@
 #!/bin/sh
 set -e
 
-echo "Waiting for postgres at ${DB_HOST}:${DB_PORT}..."
+echo "Running migrations..."
 
-until nc -z "$DB_HOST" "$DB_PORT"; do
-  sleep 0.5
-done
-
-echo "Postgres is up. Running migrations..."
-
-psql "postgres://${DB_USER}:${DB_PASSWORD}@${DB_HOST}:${DB_PORT}/${DB_NAME}?sslmode=disable" \
-  -f /app/migrations/0001_init.up.sql
+./pr-reviewer-service migrate up
 
 echo "Migrations done. Starting app..."
 
-exec ./pr-reviewer-service
\ No newline at end of file
+exec ./pr-reviewer-service
@@ -1,75 +1,414 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	http2 "net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/graphql"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/grpc"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/health"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/admin"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/apiv2"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/audit"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/events"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/export"
+	healthHandlers "github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/health"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/imports"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/pull_requests"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/roles"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/stats"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/teams"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/tokens"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/handlers/users"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/middleware"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/metrics"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/migrate"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/migrations"
 	"github.com/jackc/pgx/v5/pgxpool"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type App struct {
-	config       *config.Config
-	logger       *log.Logger
-	db           *pgxpool.Pool
-	Router       *http.Router
-	UsersHandler *users.UsersHandler
-	TeamHandler  *teams.TeamHandler
-	PRHandler    *pull_requests.PullRequestHandler
-	StatsHandler *stats.StatsHandler
+	config        *config.Config
+	logger        *slog.Logger
+	db            *pgxpool.Pool
+	metrics       *metrics.Metrics
+	tracer        trace.TracerProvider
+	authenticator auth.Authenticator
+	idempotency   service.IdempotencyService
+	reviewEvents  service.ReviewEventService
+	workers       []worker
+	running       atomic.Int32
+	probe         *health.Probe
+	// grpcServer — nil, если gRPC выключен.
+	grpcServer    *grpc.Server
+	Router        *http.Router
+	UsersHandler  *users.UsersHandler
+	TeamHandler   *teams.TeamHandler
+	PRHandler     *pull_requests.PullRequestHandler
+	StatsHandler  *stats.StatsHandler
+	ExportHandler *export.ExportHandler
+	ImportHandler *imports.ImportHandler
+	BackupHandler *admin.BackupHandler
+	TokenHandler  *tokens.TokenHandler
+	RoleHandler   *roles.RoleHandler
+	AuditHandler  *audit.AuditHandler
+	EventsHandler *events.EventsHandler
+	V2Handler     *apiv2.Handler
+	// GraphQLHandler — nil, если /graphql выключен.
+	GraphQLHandler *graphql.Handler
+	HealthHandler  *healthHandlers.HealthHandler
 }
 
-func NewApp(config *config.Config, logger *log.Logger, pool *pgxpool.Pool) *App {
+// NewApp собирает зависимости. jwks — ключи OIDC-провайдера; nil, если вход через SSO выключен.
+func NewApp(config *config.Config, logger *slog.Logger, pool *pgxpool.Pool, tp trace.TracerProvider, jwks auth.KeySet) *App {
 	// Repositories
-	teamRepo := postgres.NewTeamRepository(pool)
+	teamRepo := postgres.NewTeamRepository(pool, logger)
 	usersRepo := postgres.NewUserRepository(pool)
-	prRepo := postgres.NewPullRequestRepository(pool)
+	prRepo := postgres.NewPullRequestRepository(pool, logger)
 	statsRepo := postgres.NewStatsRepository(pool)
+	exportRepo := postgres.NewExportRepository(pool)
+	importRepo := postgres.NewImportRepository(pool, logger)
+	backupRepo := postgres.NewBackupRepository(pool, logger)
+	tokenRepo := postgres.NewTokenRepository(pool)
+	roleRepo := postgres.NewRoleRepository(pool)
+	auditRepo := postgres.NewAuditRepository(pool)
+	idempotencyRepo := postgres.NewIdempotencyRepository(pool)
+	transactor := postgres.NewTransactor(pool, logger)
+
+	// Metrics
+	appMetrics := metrics.New()
+	appMetrics.Register(
+		metrics.NewPoolCollector(pool),
+		metrics.NewOpenPullRequestsCollector(statsRepo),
+	)
 
 	// Services
-	teamService := service.NewTeamService(teamRepo)
-	usersService := service.NewUserService(usersRepo)
-	prService := service.NewPullRequestService(prRepo, usersRepo, teamRepo)
+	reviewEventService := service.NewReviewEventService(auditRepo, usersRepo, logger)
+	auditService := service.NewAuditService(auditRepo, logger, reviewEventService)
+	teamService := service.NewTeamService(teamRepo, transactor, auditService, logger)
+	usersService := service.NewUserService(usersRepo, transactor, auditService, logger)
+	prService := service.NewTracedPullRequestService(
+		service.NewPullRequestService(prRepo, usersRepo, teamRepo, transactor, auditService, appMetrics, logger),
+		tp,
+	)
 	statsService := service.NewStatsService(statsRepo)
+	dashboardService := service.NewDashboardService(teamRepo, usersRepo, prRepo)
+	exportService := service.NewExportService(exportRepo)
+	importService := service.NewImportService(importRepo, transactor, auditService, logger)
+	backupService := service.NewBackupService(backupRepo, transactor, auditService, logger)
+	tokenService := service.NewTokenService(tokenRepo, roleRepo, transactor, auditService, logger)
+	roleService := service.NewRoleService(roleRepo, transactor, auditService, logger)
+	idempotencyService := service.NewIdempotencyService(idempotencyRepo, config.Idempotency.TTL, config.Idempotency.LockTimeout, logger)
+
+	var jwtAuthenticator auth.Authenticator
+	if jwks != nil {
+		jwtAuthenticator = service.NewJWTAuthenticator(auth.NewJWTVerifier(config.OIDC, jwks), usersRepo, roleRepo, logger)
+	}
 
 	// Handlers
 	teamHandler := teams.NewTeamHandler(teamService)
 	usersHandler := users.NewUsersHandler(usersService)
 	prHandler := pull_requests.NewPullRequestHandler(prService)
 	statsHandler := stats.NewStatsHandler(statsService)
+	exportHandler := export.NewExportHandler(exportService)
+	importHandler := imports.NewImportHandler(importService)
+	backupHandler := admin.NewBackupHandler(backupService)
+	tokenHandler := tokens.NewTokenHandler(tokenService)
+	roleHandler := roles.NewRoleHandler(roleService)
+	auditHandler := audit.NewAuditHandler(auditService)
+	eventsHandler := events.NewEventsHandler(reviewEventService, config.Events)
+	v2Handler := apiv2.NewHandler(teamService, usersService, prService)
+
+	// Health
+	probe := health.NewProbe()
+	healthHandler := healthHandlers.NewHealthHandler(probe)
 
 	app := &App{
-		config:       config,
-		logger:       logger,
-		db:           pool,
-		Router:       http.NewRouter(),
-		UsersHandler: usersHandler,
-		TeamHandler:  teamHandler,
-		PRHandler:    prHandler,
-		StatsHandler: statsHandler,
+		config:        config,
+		logger:        logger,
+		db:            pool,
+		metrics:       appMetrics,
+		tracer:        tp,
+		authenticator: auth.Dispatch(tokenService, jwtAuthenticator),
+		idempotency:   idempotencyService,
+		reviewEvents:  reviewEventService,
+		Router:        http.NewRouter(appMetrics),
+		UsersHandler:  usersHandler,
+		TeamHandler:   teamHandler,
+		PRHandler:     prHandler,
+		StatsHandler:  statsHandler,
+		ExportHandler: exportHandler,
+		ImportHandler: importHandler,
+		BackupHandler: backupHandler,
+		TokenHandler:  tokenHandler,
+		RoleHandler:   roleHandler,
+		AuditHandler:  auditHandler,
+		EventsHandler: eventsHandler,
+		V2Handler:     v2Handler,
+		HealthHandler: healthHandler,
+		probe:         probe,
+	}
+
+	if config.GRPC.Enabled {
+		var grpcAuthenticator auth.Authenticator
+		if config.Auth.Enabled {
+			grpcAuthenticator = app.authenticator
+		}
+		app.grpcServer = grpc.NewServer(config.GRPC, grpc.Services{
+			Teams:        teamService,
+			Users:        usersService,
+			PullRequests: prService,
+			Stats:        statsService,
+		}, grpcAuthenticator, tp, logger)
+	}
+
+	if config.GraphQL.Enabled {
+		app.GraphQLHandler = graphql.NewHandler(config.GraphQL, dashboardService, statsService)
 	}
 
+	app.configureProbe()
 	app.configureRouter()
+	app.AddWorker("idempotency-cleanup", app.cleanupIdempotencyKeys)
 
 	return app
 }
 
-func (a *App) Start() error {
-	addr := fmt.Sprintf(":%d", a.config.HTTP.Port)
-	a.logger.Printf("starting http server on %s", addr)
-	return http2.ListenAndServe(addr, a.Router.Handler())
+type worker struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+// AddWorker регистрирует фоновую задачу. Задачи запускаются в Run и получают
+// контекст, который отменяется после того, как HTTP-сервер перестал принимать запросы.
+func (a *App) AddWorker(name string, run func(ctx context.Context)) {
+	a.workers = append(a.workers, worker{name: name, run: run})
+}
+
+// Run запускает HTTP-сервер, gRPC-сервер (если включён) и фоновые задачи и блокируется до отмены ctx
+// (SIGTERM/SIGINT) или ошибки сервера. Остановка идёт по порядку: /readyz и gRPC health
+// переходят в «не готов», серверы перестают принимать соединения и дожидаются активных запросов, затем
+// останавливаются фоновые задачи. Пул соединений закрывает вызывающий код.
+func (a *App) Run(ctx context.Context) error {
+	httpCfg := a.config.HTTP
+
+	srv := &http2.Server{
+		Addr:              net.JoinHostPort(httpCfg.Host, strconv.Itoa(httpCfg.Port)),
+		Handler:           a.Router.Handler(),
+		ReadTimeout:       httpCfg.ReadTimeout,
+		ReadHeaderTimeout: httpCfg.ReadHeaderTimeout,
+		WriteTimeout:      httpCfg.WriteTimeout,
+		IdleTimeout:       httpCfg.IdleTimeout,
+	}
+	// Потоки /events/stream не завершаются сами: при остановке их закрывает сервис событий.
+	srv.RegisterOnShutdown(a.reviewEvents.Close)
+
+	var grpcListener net.Listener
+	if a.grpcServer != nil {
+		grpcAddr := net.JoinHostPort(a.config.GRPC.Host, strconv.Itoa(a.config.GRPC.Port))
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return fmt.Errorf("listen grpc %s: %w", grpcAddr, err)
+		}
+		grpcListener = lis
+	}
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var wg sync.WaitGroup
+	for _, w := range a.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.logger.Info("starting worker", slog.String("worker", w.name))
+			a.running.Add(1)
+			defer a.running.Add(-1)
+			w.run(workersCtx)
+			a.logger.Info("worker stopped", slog.String("worker", w.name))
+		}()
+	}
+
+	serveErr := make(chan error, 2)
+	go func() {
+		a.logger.Info("starting http server", slog.String("addr", srv.Addr))
+		serveErr <- srv.ListenAndServe()
+	}()
+	if grpcListener != nil {
+		go func() {
+			a.logger.Info("starting grpc server", slog.String("addr", grpcListener.Addr().String()))
+			if err := a.grpcServer.Serve(grpcListener); err != nil {
+				serveErr <- fmt.Errorf("grpc server: %w", err)
+			}
+		}()
+	}
+
+	var runErr error
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http2.ErrServerClosed) {
+			runErr = err
+		}
+	case <-ctx.Done():
+		a.logger.Info("shutdown signal received, draining http server")
+	}
+
+	// Сначала /readyz начинает отвечать 503, затем после паузы сервер перестаёт принимать соединения.
+	a.probe.SetShuttingDown()
+	if a.grpcServer != nil {
+		a.grpcServer.SetNotServing()
+	}
+	if delay := httpCfg.ShutdownDelay; delay > 0 && runErr == nil {
+		time.Sleep(delay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), httpCfg.ShutdownTimeout)
+	defer cancel()
+
+	grpcStopped := make(chan error, 1)
+	if a.grpcServer != nil {
+		go func() {
+			grpcStopped <- a.grpcServer.Stop(shutdownCtx)
+		}()
+	} else {
+		grpcStopped <- nil
+	}
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		a.logger.Error("http server shutdown", slog.Any("error", err))
+		runErr = errors.Join(runErr, err)
+	}
+	if err := <-grpcStopped; err != nil {
+		a.logger.Error("grpc server shutdown", slog.Any("error", err))
+		runErr = errors.Join(runErr, err)
+	}
+
+	stopWorkers()
+
+	workersDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(workersDone)
+	}()
+
+	select {
+	case <-workersDone:
+	case <-shutdownCtx.Done():
+		a.logger.Error("background workers did not stop before shutdown timeout")
+		runErr = errors.Join(runErr, shutdownCtx.Err())
+	}
+
+	a.logger.Info("http server stopped")
+
+	return runErr
+}
+
+func (a *App) configureProbe() {
+	a.probe.AddCheck("database", func(ctx context.Context) error {
+		return a.db.Ping(ctx)
+	})
+
+	migrator, migratorErr := migrate.New(a.db, migrations.FS)
+	a.probe.AddCheck("migrations", func(ctx context.Context) error {
+		if migratorErr != nil {
+			return migratorErr
+		}
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("%w: %d pending migrations", migrate.ErrOutdated, pending)
+		}
+		return nil
+	})
+
+	a.probe.AddCheck("workers", func(ctx context.Context) error {
+		if running, total := int(a.running.Load()), len(a.workers); running < total {
+			return fmt.Errorf("%d of %d background workers running", running, total)
+		}
+		return nil
+	})
+}
+
+// cleanupIdempotencyKeys периодически удаляет истёкшие ключи идемпотентности.
+func (a *App) cleanupIdempotencyKeys(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Idempotency.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := a.idempotency.DeleteExpired(ctx)
+			if err != nil {
+				a.logger.Error("delete expired idempotency keys", slog.Any("error", err))
+				continue
+			}
+			if deleted > 0 {
+				a.logger.Info("expired idempotency keys deleted", slog.Int64("count", deleted))
+			}
+		}
+	}
 }
 
 func (a *App) configureRouter() {
+	// Middleware: идентификатор запроса и спан нужны access-логу, а access-лог
+	// и спан должны увидеть 500, который Recover отдаёт при панике.
+	a.Router.Use(
+		middleware.RequestID,
+		middleware.Tracing(a.tracer),
+		middleware.AccessLog(a.logger),
+		middleware.Recover(a.logger),
+	)
+	a.Router.SetBodyLimit(a.config.HTTP.MaxBodyBytes)
+	uploadLimit := http.WithBodyLimit(a.config.HTTP.MaxUploadBytes)
+	if a.config.Auth.Enabled {
+		a.Router.SetAuth(middleware.Authenticate(a.authenticator))
+	} else {
+		a.Router.SetAuth(middleware.AssumeIdentity(auth.Anonymous()))
+	}
+	a.Router.SetIdempotency(middleware.Idempotency(a.idempotency, a.logger))
+	public := http.Public()
+
+	// Health
+	a.Router.HandleFunc("/healthz", a.HealthHandler.Healthz, public)
+	a.Router.HandleFunc("/readyz", a.HealthHandler.Readyz, public)
+	a.Router.HandleFunc("/version", a.HealthHandler.Version, public)
+
+	// Tokens
+	a.Router.HandleFunc("/tokens/create", a.TokenHandler.Create)
+	a.Router.HandleFunc("/tokens/list", a.TokenHandler.List)
+	a.Router.HandleFunc("/tokens/revoke", a.TokenHandler.Revoke)
+
+	// Roles
+	a.Router.HandleFunc("/roles/list", a.RoleHandler.List)
+	a.Router.HandleFunc("/roles/assignments", a.RoleHandler.Assignments)
+	a.Router.HandleFunc("/roles/assign", a.RoleHandler.Assign)
+	a.Router.HandleFunc("/roles/unassign", a.RoleHandler.Unassign)
+
+	// Audit
+	a.Router.HandleFunc("/audit", a.AuditHandler.List)
+
+	// Events
+	a.Router.HandleFunc("/events/stream", a.EventsHandler.Stream)
+
 	// Teams
 	a.Router.HandleFunc("/team/add", a.TeamHandler.Add)
 	a.Router.HandleFunc("/team/get", a.TeamHandler.Get)
@@ -77,15 +416,57 @@ func (a *App) configureRouter() {
 	// Users
 	a.Router.HandleFunc("/users/setIsActive", a.UsersHandler.SetIsActive)
 	a.Router.HandleFunc("/users/getReview", a.UsersHandler.GetReview)
+	a.Router.HandleFunc("/me", a.UsersHandler.Me)
+	a.Router.HandleFunc("/me/reviews", a.UsersHandler.MyReviews)
 
 	// Pull Requests
 	a.Router.HandleFunc("/pullRequest/create", a.PRHandler.Create)
+	a.Router.HandleFunc("/pullRequest/get", a.PRHandler.Get)
 	a.Router.HandleFunc("/pullRequest/merge", a.PRHandler.Merge)
 	a.Router.HandleFunc("/pullRequest/reassign", a.PRHandler.ReAssign)
+	a.Router.HandleFunc("/pullRequest/review", a.PRHandler.Review)
 
 	// Stats
 	a.Router.HandleFunc("/stats/reviewers", a.StatsHandler.GetReviewerStats)
+	a.Router.HandleFunc("/stats/teams", a.StatsHandler.GetTeamStats)
+	a.Router.HandleFunc("/stats/latency", a.StatsHandler.GetLatency)
+	a.Router.HandleFunc("/stats/fairness", a.StatsHandler.GetFairness)
+
+	// Export
+	a.Router.HandleFunc("/export/pullRequests", a.ExportHandler.PullRequests)
+	a.Router.HandleFunc("/export/assignments", a.ExportHandler.Assignments)
+	a.Router.HandleFunc("/export/reviewerStats", a.ExportHandler.ReviewerStats)
+
+	// Import
+	a.Router.HandleFunc("/import", a.ImportHandler.Import, uploadLimit)
+
+	// Admin
+	a.Router.HandleFunc("/admin/backup", a.BackupHandler.Backup)
+	a.Router.HandleFunc("/admin/restore", a.BackupHandler.Restore, uploadLimit)
+
+	// API v2
+	v2 := apiv2.Prefix
+	a.Router.HandleFunc("POST "+v2+"/teams", a.V2Handler.CreateTeam)
+	a.Router.HandleFunc("GET "+v2+"/teams/{name}", a.V2Handler.GetTeam)
+	a.Router.HandleFunc("GET "+v2+"/teams/{name}/members", a.V2Handler.ListTeamMembers)
+	a.Router.HandleFunc("GET "+v2+"/users/{id}", a.V2Handler.GetUser)
+	a.Router.HandleFunc("PATCH "+v2+"/users/{id}", a.V2Handler.UpdateUser)
+	a.Router.HandleFunc("GET "+v2+"/users/{id}/reviews", a.V2Handler.ListUserReviews)
+	a.Router.HandleFunc("GET "+v2+"/me", a.V2Handler.Me)
+	a.Router.HandleFunc("POST "+v2+"/pull-requests", a.V2Handler.CreatePullRequest)
+	a.Router.HandleFunc("GET "+v2+"/pull-requests/{id}", a.V2Handler.GetPullRequest)
+	a.Router.HandleFunc("POST "+v2+"/pull-requests/{id}/merge", a.V2Handler.MergePullRequest)
+	a.Router.HandleFunc("POST "+v2+"/pull-requests/{id}/reviewers/{user_id}/reassign", a.V2Handler.ReassignReviewer)
+	a.Router.HandleFunc("PUT "+v2+"/pull-requests/{id}/reviews/{reviewer_id}", a.V2Handler.SubmitReview)
+
+	// GraphQL
+	if a.GraphQLHandler != nil {
+		a.Router.Handle("/graphql", a.GraphQLHandler)
+	}
+
+	// Metrics
+	a.Router.Handle("/metrics", a.metrics.Handler(), public)
 
 	// Swagger UI
-	a.Router.Handle("/swagger/", httpSwagger.WrapHandler)
+	a.Router.Handle("/swagger/", httpSwagger.WrapHandler, public)
 }
@@ -14,15 +14,37 @@ const (
 	CodeNotAssigned Code = "NOT_ASSIGNED"
 	CodeNoCandidate Code = "NO_CANDIDATE"
 	CodeNotFound    Code = "NOT_FOUND"
+	// CodeConflict — ресурс изменился с версии, которую прислал клиент (If-Match).
+	CodeConflict Code = "CONFLICT"
+	// CodeConcurrentUpdate — ресурс раз за разом менялся параллельно с запросом
+	// без If-Match, и сервис не смог применить изменение.
+	CodeConcurrentUpdate Code = "CONCURRENT_UPDATE"
 
-	CodeValidation Code = "VALIDATION"
-	CodeInternal   Code = "INTERNAL"
+	CodeIdempotencyKeyReused  Code = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyInProgress Code = "IDEMPOTENCY_IN_PROGRESS"
+
+	CodeUnauthorized Code = "UNAUTHORIZED"
+	CodeForbidden    Code = "FORBIDDEN"
+
+	CodeValidation      Code = "VALIDATION"
+	CodePayloadTooLarge Code = "PAYLOAD_TOO_LARGE"
+	CodeInternal        Code = "INTERNAL"
 )
 
+// FieldError описывает нарушение правила для одного поля запроса, чтобы клиенту
+// не приходилось разбирать Message.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
 type AppError struct {
 	Code    Code
 	Message string
 	Err     error
+	// Details — ошибки отдельных полей; заполняется для CodeValidation.
+	Details []FieldError
 }
 
 func (e *AppError) Error() string {
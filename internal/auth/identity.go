@@ -0,0 +1,71 @@
+// Package auth описывает аутентифицированного вызывающего (actor) и способы
+// его получения: API-токены и OIDC JWT от SSO.
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+)
+
+// Identity — тот, от чьего имени выполняется запрос.
+type Identity struct {
+	// Subject — идентификатор для логов и аудита: user_id или token:<id>.
+	Subject string
+	UserID  string
+	TokenID string
+	IsAdmin bool
+	// Grants — права из ролей пользователя, загруженные при аутентификации.
+	Grants []domain.Grant
+}
+
+// System — идентичность для CLI-команд, запускаемых оператором на сервере.
+func System() *Identity {
+	return &Identity{Subject: "system", IsAdmin: true}
+}
+
+// Anonymous — идентичность запросов при auth.enabled = false. Права у неё
+// администраторские: отказ от проверки — решение конфигурации, которое роутер
+// и gRPC-сервер применяют явно, а не следствие отсутствия идентичности.
+func Anonymous() *Identity {
+	return &Identity{Subject: "anonymous", IsAdmin: true}
+}
+
+type ctxKey struct{}
+
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext возвращает идентичность запроса или nil для анонимного вызова.
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(ctxKey{}).(*Identity)
+	return id
+}
+
+// Authenticator проверяет bearer-токен из заголовка Authorization.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+}
+
+// AuthenticatorFunc позволяет использовать функцию как Authenticator.
+type AuthenticatorFunc func(ctx context.Context, token string) (*Identity, error)
+
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	return f(ctx, token)
+}
+
+// Dispatch направляет API-токены (с префиксом TokenPrefix) в tokens, остальные
+// bearer-токены — в jwt. Без jwt все токены проверяет tokens.
+func Dispatch(tokens, jwt Authenticator) Authenticator {
+	if jwt == nil {
+		return tokens
+	}
+	return AuthenticatorFunc(func(ctx context.Context, token string) (*Identity, error) {
+		if strings.HasPrefix(token, TokenPrefix) {
+			return tokens.Authenticate(ctx, token)
+		}
+		return jwt.Authenticate(ctx, token)
+	})
+}
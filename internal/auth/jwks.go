@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+// KeySet отдаёт ключи проверки подписи JWT.
+type KeySet interface {
+	// Key возвращает ключ по kid из заголовка токена. Пустой kid допустим,
+	// если в наборе ровно один ключ.
+	Key(ctx context.Context, kid string) (*jose.JSONWebKey, error)
+}
+
+// LoadJWKSFile читает JWKS из файла один раз при старте.
+func LoadJWKSFile(path string) (KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks file: %w", err)
+	}
+	if len(set.Keys) == 0 {
+		return nil, fmt.Errorf("jwks file %s contains no keys", path)
+	}
+
+	return staticKeySet{set: set}, nil
+}
+
+type staticKeySet struct {
+	set jose.JSONWebKeySet
+}
+
+func (s staticKeySet) Key(_ context.Context, kid string) (*jose.JSONWebKey, error) {
+	return findKey(s.set, kid)
+}
+
+func findKey(set jose.JSONWebKeySet, kid string) (*jose.JSONWebKey, error) {
+	if kid == "" {
+		if len(set.Keys) == 1 {
+			return &set.Keys[0], nil
+		}
+		return nil, fmt.Errorf("token has no kid and jwks has %d keys", len(set.Keys))
+	}
+	if keys := set.Key(kid); len(keys) > 0 {
+		return &keys[0], nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// minRefetchInterval ограничивает внеплановые загрузки JWKS при неизвестном kid,
+// чтобы поток токенов с чужими kid не превращался в поток запросов к SSO.
+const minRefetchInterval = time.Minute
+
+// fetchTimeout ограничивает одну загрузку JWKS. Загрузка не привязана к
+// запросу, который её начал: её результат ждут и другие запросы.
+const fetchTimeout = 10 * time.Second
+
+type remoteKeySet struct {
+	url     string
+	refresh time.Duration
+	client  *http.Client
+
+	// group объединяет одновременные загрузки; сама загрузка идёт без mu,
+	// чтобы медленный SSO не задерживал токены с уже известными ключами.
+	group singleflight.Group
+
+	mu          sync.Mutex
+	set         jose.JSONWebKeySet
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewRemoteJWKS загружает JWKS по url при первом обращении и обновляет его
+// раз в refresh, а также при появлении неизвестного kid (ротация ключей).
+func NewRemoteJWKS(url string, refresh time.Duration, client *http.Client) KeySet {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &remoteKeySet{url: url, refresh: refresh, client: client}
+}
+
+func (s *remoteKeySet) Key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	set, fresh, _ := s.current()
+	key, findErr := findKey(set, kid)
+	if findErr == nil && fresh {
+		return key, nil
+	}
+
+	// Набор устарел или в нём нет kid — обновляем, но не чаще minRefetchInterval.
+	// Если ключ есть в устаревшем наборе, обновление идёт в фоне.
+	done := s.group.DoChan("jwks", func() (any, error) {
+		return nil, s.reload(context.WithoutCancel(ctx))
+	})
+	if findErr == nil {
+		return key, nil
+	}
+
+	var err error
+	select {
+	case res := <-done:
+		err = res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// Если SSO недоступен, продолжаем работать с последним загруженным набором.
+	set, _, loaded := s.current()
+	if err != nil && !loaded {
+		return nil, err
+	}
+	return findKey(set, kid)
+}
+
+// current возвращает загруженный набор и признаки «не устарел» и «загружен хоть раз».
+func (s *remoteKeySet) current() (set jose.JSONWebKeySet, fresh, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loaded = !s.fetchedAt.IsZero()
+	fresh = loaded && (s.refresh == 0 || time.Since(s.fetchedAt) < s.refresh)
+	return s.set, fresh, loaded
+}
+
+// reload загружает набор заново, если с прошлой попытки прошло не меньше
+// minRefetchInterval.
+func (s *remoteKeySet) reload(ctx context.Context) error {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Sub(s.attemptedAt) < minRefetchInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.attemptedAt = now
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	set, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set, s.fetchedAt = set, now
+	return nil
+}
+
+func (s *remoteKeySet) fetch(ctx context.Context) (jose.JSONWebKeySet, error) {
+	var set jose.JSONWebKeySet
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return set, fmt.Errorf("build jwks request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return set, fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return set, fmt.Errorf("fetch jwks: unexpected status %s", resp.Status)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&set); err != nil {
+		return set, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	return set, nil
+}
+
+// NewKeySet выбирает источник ключей по конфигурации: файл или URL.
+func NewKeySet(cfg config.OIDCConfig, client *http.Client) (KeySet, error) {
+	if cfg.JWKSFile != "" {
+		return LoadJWKSFile(cfg.JWKSFile)
+	}
+	return NewRemoteJWKS(cfg.JWKSURL, cfg.JWKSRefresh, client), nil
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTClaims — то, что сервису нужно из проверенного токена SSO.
+type JWTClaims struct {
+	UserID string
+	// Roles — роли из RolesClaim; UserID в них заполнен.
+	Roles []domain.RoleAssignment
+}
+
+// JWTVerifier проверяет подпись, issuer, audience и сроки OIDC-токенов.
+type JWTVerifier struct {
+	cfg  config.OIDCConfig
+	keys KeySet
+	now  func() time.Time
+}
+
+func NewJWTVerifier(cfg config.OIDCConfig, keys KeySet) *JWTVerifier {
+	return &JWTVerifier{cfg: cfg, keys: keys, now: time.Now}
+}
+
+func (v *JWTVerifier) Verify(ctx context.Context, raw string) (*JWTClaims, error) {
+	tok, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("parse jwt: %w", err)
+	}
+	if len(tok.Headers) != 1 {
+		return nil, errors.New("jwt must have exactly one signature")
+	}
+
+	key, err := v.keys.Key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		std   jwt.Claims
+		extra map[string]any
+	)
+	if err := tok.Claims(key, &std, &extra); err != nil {
+		return nil, fmt.Errorf("verify jwt: %w", err)
+	}
+
+	if std.Expiry == nil {
+		return nil, errors.New("jwt has no exp claim")
+	}
+	expected := jwt.Expected{Issuer: v.cfg.Issuer, AnyAudience: jwt.Audience{v.cfg.Audience}, Time: v.now()}
+	if err := std.ValidateWithLeeway(expected, v.cfg.Leeway); err != nil {
+		return nil, fmt.Errorf("validate jwt: %w", err)
+	}
+
+	userID, _ := claimValue(extra, v.cfg.UserClaim).(string)
+	if userID == "" {
+		return nil, fmt.Errorf("jwt has no %s claim", v.cfg.UserClaim)
+	}
+
+	claims := &JWTClaims{UserID: userID}
+	if v.cfg.RolesClaim != "" {
+		claims.Roles = parseRoles(userID, claimValue(extra, v.cfg.RolesClaim))
+	}
+
+	return claims, nil
+}
+
+// claimValue достаёт claim по пути через точку, например realm_access.roles.
+func claimValue(claims map[string]any, path string) any {
+	var cur any = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[part]
+	}
+	return cur
+}
+
+// parseRoles разбирает роли вида "admin" и "team_lead:backend" из массива
+// строк или строки, разделённой пробелами.
+func parseRoles(userID string, v any) []domain.RoleAssignment {
+	var values []string
+	switch rv := v.(type) {
+	case string:
+		values = strings.Fields(rv)
+	case []any:
+		for _, item := range rv {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	roles := make([]domain.RoleAssignment, 0, len(values))
+	for _, s := range values {
+		role, team, _ := strings.Cut(s, ":")
+		if role == "" {
+			continue
+		}
+		roles = append(roles, domain.RoleAssignment{UserID: userID, Role: role, TeamName: team})
+	}
+	return roles
+}
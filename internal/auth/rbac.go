@@ -0,0 +1,29 @@
+package auth
+
+import "github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+
+// Can сообщает, есть ли у субъекта право perm в команде team. Глобальное право
+// действует в любой команде. Администратор может всё; без идентичности нельзя
+// ничего — выключенная аутентификация представлена явной Anonymous.
+func (id *Identity) Can(perm domain.Permission, team string) bool {
+	if id == nil {
+		return false
+	}
+	if id.IsAdmin {
+		return true
+	}
+	for _, g := range id.Grants {
+		if g.Permission != perm {
+			continue
+		}
+		if g.TeamName == "" || g.TeamName == team {
+			return true
+		}
+	}
+	return false
+}
+
+// Is сообщает, действует ли субъект от имени пользователя userID.
+func (id *Identity) Is(userID string) bool {
+	return id != nil && id.UserID != "" && id.UserID == userID
+}
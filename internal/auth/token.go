@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// TokenPrefix отличает API-токены сервиса от других секретов (например, при поиске утечек).
+const TokenPrefix = "prs_"
+
+// NewToken генерирует идентификатор токена и сам секретный токен.
+func NewToken() (id, token string) {
+	var idBytes [8]byte
+	_, _ = rand.Read(idBytes[:])
+
+	var secret [32]byte
+	_, _ = rand.Read(secret[:])
+
+	return "tok_" + hex.EncodeToString(idBytes[:]), TokenPrefix + base64.RawURLEncoding.EncodeToString(secret[:])
+}
+
+// HashToken возвращает хэш для хранения и поиска. У токена 256 бит энтропии,
+// поэтому медленный хэш (bcrypt и т.п.) не нужен.
+func HashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
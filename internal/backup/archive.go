@@ -0,0 +1,136 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// FormatVersion — версия формата архива. Увеличивается при несовместимых изменениях;
+// восстановление поддерживает все версии до текущей включительно.
+// Версия 2 добавила назначения ролей и версию PR.
+const FormatVersion = 2
+
+type Archive struct {
+	Version      int             `json:"version"`
+	CreatedAt    time.Time       `json:"created_at"`
+	Teams        []Team          `json:"teams"`
+	Users        []User          `json:"users"`
+	UserRoles    []UserRole      `json:"user_roles"`
+	PullRequests []PullRequest   `json:"pull_requests"`
+	Reviewers    []Reviewer      `json:"reviewers"`
+	ActivityLog  []ActivityEntry `json:"activity_log"`
+}
+
+type Team struct {
+	Name string `json:"name"`
+}
+
+type User struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	IsActive bool    `json:"is_active"`
+	TeamName *string `json:"team_name"`
+}
+
+// UserRole — назначение роли; TeamName = nil — роль действует глобально.
+type UserRole struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	TeamName  *string   `json:"team_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PullRequest struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	AuthorID  string     `json:"author_id"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+	// Version в архивах версии 1 нет: такие PR восстанавливаются с версией 1.
+	Version int64 `json:"version,omitempty"`
+}
+
+type Reviewer struct {
+	PullRequestID string     `json:"pull_request_id"`
+	ReviewerID    string     `json:"reviewer_id"`
+	AssignedAt    time.Time  `json:"assigned_at"`
+	ReviewedAt    *time.Time `json:"reviewed_at"`
+}
+
+type ActivityEntry struct {
+	UserID    string    `json:"user_id"`
+	IsActive  bool      `json:"is_active"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// Writer пишет архив потоково, секция за секцией, не собирая его в памяти.
+type Writer struct {
+	w     io.Writer
+	err   error
+	first bool
+}
+
+func NewWriter(w io.Writer, createdAt time.Time) *Writer {
+	aw := &Writer{w: w}
+	aw.printf(`{"version":%d,"created_at":`, FormatVersion)
+	aw.encode(createdAt)
+	return aw
+}
+
+// BeginSection открывает массив name. Секции должны закрываться EndSection.
+func (aw *Writer) BeginSection(name string) {
+	aw.printf(`,%q:[`, name)
+	aw.first = true
+}
+
+func (aw *Writer) Item(v any) error {
+	if !aw.first {
+		aw.printf(",")
+	}
+	aw.first = false
+	aw.encode(v)
+	return aw.err
+}
+
+func (aw *Writer) EndSection() {
+	aw.printf("]")
+}
+
+func (aw *Writer) Close() error {
+	aw.printf("}\n")
+	return aw.err
+}
+
+func (aw *Writer) printf(format string, args ...any) {
+	if aw.err != nil {
+		return
+	}
+	_, aw.err = fmt.Fprintf(aw.w, format, args...)
+}
+
+func (aw *Writer) encode(v any) {
+	if aw.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		aw.err = err
+		return
+	}
+	_, aw.err = aw.w.Write(b)
+}
+
+// Read разбирает архив и проверяет версию формата.
+func Read(r io.Reader) (*Archive, error) {
+	var a Archive
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, fmt.Errorf("decode archive: %w", err)
+	}
+	if a.Version < 1 || a.Version > FormatVersion {
+		return nil, fmt.Errorf("unsupported archive version %d (supported up to %d)", a.Version, FormatVersion)
+	}
+	return &a, nil
+}
@@ -1,36 +1,467 @@
 package config
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
 
+const (
+	defaultConfigPath = "config.toml"
+	configPathEnv     = "CONFIG_PATH"
+)
+
+// Config собирается в порядке возрастания приоритета:
+// значения по умолчанию → файл конфигурации → переменные окружения (тег env) → флаги командной строки (тег flag).
 type Config struct {
-	HTTP     HTTPConfig     `toml:"http"`
-	Postgres PostgresConfig `toml:"postgres"`
+	HTTP        HTTPConfig        `toml:"http"`
+	GRPC        GRPCConfig        `toml:"grpc"`
+	GraphQL     GraphQLConfig     `toml:"graphql"`
+	Events      EventsConfig      `toml:"events"`
+	Postgres    PostgresConfig    `toml:"postgres"`
+	Migrations  MigrationsConfig  `toml:"migrations"`
+	Log         LogConfig         `toml:"log"`
+	Tracing     TracingConfig     `toml:"tracing"`
+	Auth        AuthConfig        `toml:"auth"`
+	OIDC        OIDCConfig        `toml:"oidc"`
+	Idempotency IdempotencyConfig `toml:"idempotency"`
 }
 
 type HTTPConfig struct {
-	Port int    `toml:"port"`
-	Host string `toml:"host"`
+	Port int    `toml:"port" env:"HTTP_PORT" flag:"http-port"`
+	Host string `toml:"host" env:"HTTP_HOST" flag:"http-host"`
+
+	// Таймауты http.Server; 0 — без ограничения.
+	ReadTimeout       time.Duration `toml:"read_timeout" env:"HTTP_READ_TIMEOUT" flag:"http-read-timeout"`
+	ReadHeaderTimeout time.Duration `toml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT" flag:"http-read-header-timeout"`
+	WriteTimeout      time.Duration `toml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" flag:"http-write-timeout"`
+	IdleTimeout       time.Duration `toml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" flag:"http-idle-timeout"`
+	// ShutdownTimeout — сколько ждать завершения активных запросов после SIGTERM/SIGINT.
+	ShutdownTimeout time.Duration `toml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" flag:"http-shutdown-timeout"`
+	// ShutdownDelay — пауза между переводом /readyz в состояние «не готов» и остановкой сервера,
+	// чтобы балансировщик успел вывести инстанс из ротации.
+	ShutdownDelay time.Duration `toml:"shutdown_delay" env:"HTTP_SHUTDOWN_DELAY" flag:"http-shutdown-delay"`
+
+	// Ограничения тела запроса в байтах; 0 — без ограничения. MaxUploadBytes
+	// действует для /import и /admin/restore, MaxBodyBytes — для остальных маршрутов.
+	MaxBodyBytes   int64 `toml:"max_body_bytes" env:"HTTP_MAX_BODY_BYTES" flag:"http-max-body-bytes"`
+	MaxUploadBytes int64 `toml:"max_upload_bytes" env:"HTTP_MAX_UPLOAD_BYTES" flag:"http-max-upload-bytes"`
+}
+
+// GRPCConfig описывает gRPC-сервер, который работает рядом с HTTP и использует те же сервисы.
+type GRPCConfig struct {
+	Enabled bool   `toml:"enabled" env:"GRPC_ENABLED" flag:"grpc-enabled"`
+	Host    string `toml:"host" env:"GRPC_HOST" flag:"grpc-host"`
+	Port    int    `toml:"port" env:"GRPC_PORT" flag:"grpc-port"`
+	// Reflection включает сервис grpc.reflection для grpcurl и подобных клиентов.
+	Reflection bool `toml:"reflection" env:"GRPC_REFLECTION" flag:"grpc-reflection"`
+	// MaxRecvBytes — максимальный размер входящего сообщения.
+	MaxRecvBytes int `toml:"max_recv_bytes" env:"GRPC_MAX_RECV_BYTES" flag:"grpc-max-recv-bytes"`
+}
+
+// GraphQLConfig описывает эндпоинт /graphql для дашбордов. Ограничения
+// проверяются до выполнения запроса.
+type GraphQLConfig struct {
+	Enabled bool `toml:"enabled" env:"GRAPHQL_ENABLED" flag:"graphql-enabled"`
+	// MaxDepth — наибольшая вложенность полей в запросе.
+	MaxDepth int `toml:"max_depth" env:"GRAPHQL_MAX_DEPTH" flag:"graphql-max-depth"`
+	// MaxComplexity — наибольшая стоимость запроса: каждое поле стоит 1, вложенные
+	// поля списка умножаются на его limit.
+	MaxComplexity int `toml:"max_complexity" env:"GRAPHQL_MAX_COMPLEXITY" flag:"graphql-max-complexity"`
+	// Introspection разрешает запросы __schema и __type.
+	Introspection bool `toml:"introspection" env:"GRAPHQL_INTROSPECTION" flag:"graphql-introspection"`
+}
+
+// EventsConfig описывает поток событий ревью /events/stream.
+type EventsConfig struct {
+	// PollInterval — как часто поток перечитывает журнал аудита, чтобы увидеть
+	// события других экземпляров сервиса. Свои события приходят сразу.
+	PollInterval time.Duration `toml:"poll_interval" env:"EVENTS_POLL_INTERVAL" flag:"events-poll-interval"`
+	// HeartbeatInterval — как часто в простаивающий поток пишется комментарий,
+	// чтобы прокси не закрывали соединение.
+	HeartbeatInterval time.Duration `toml:"heartbeat_interval" env:"EVENTS_HEARTBEAT_INTERVAL" flag:"events-heartbeat-interval"`
 }
 
 type PostgresConfig struct {
-	Host     string `toml:"host"`
-	Port     int    `toml:"port"`
-	User     string `toml:"user"`
-	Password string `toml:"password"`
-	Database string `toml:"database"`
-	SSLMode  string `toml:"sslmode"`
+	Host     string `toml:"host" env:"DB_HOST" flag:"db-host"`
+	Port     int    `toml:"port" env:"DB_PORT" flag:"db-port"`
+	User     string `toml:"user" env:"DB_USER" flag:"db-user"`
+	Password string `toml:"password" env:"DB_PASSWORD" flag:"db-password"`
+	Database string `toml:"database" env:"DB_NAME" flag:"db-name"`
+	SSLMode  string `toml:"sslmode" env:"DB_SSLMODE" flag:"db-sslmode"`
+
+	// Размер пула; 0 — значение pgxpool по умолчанию.
+	MaxConns        int32         `toml:"max_conns" env:"DB_MAX_CONNS" flag:"db-max-conns"`
+	MinConns        int32         `toml:"min_conns" env:"DB_MIN_CONNS" flag:"db-min-conns"`
+	MaxConnLifetime time.Duration `toml:"max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME" flag:"db-max-conn-lifetime"`
+	MaxConnIdleTime time.Duration `toml:"max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME" flag:"db-max-conn-idle-time"`
+
+	ConnectTimeout   time.Duration `toml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" flag:"db-connect-timeout"`
+	StatementTimeout time.Duration `toml:"statement_timeout" env:"DB_STATEMENT_TIMEOUT" flag:"db-statement-timeout"`
+}
+
+type MigrationsConfig struct {
+	// AutoMigrate применяет миграции при старте сервера. Если выключено,
+	// сервер не стартует на устаревшей схеме.
+	AutoMigrate bool `toml:"auto_migrate" env:"MIGRATIONS_AUTO_MIGRATE" flag:"auto-migrate"`
+}
+
+type LogConfig struct {
+	// Level — debug, info, warn или error. На уровне debug логируются SQL-запросы
+	// и решения о назначении ревьюверов.
+	Level string `toml:"level" env:"LOG_LEVEL" flag:"log-level"`
+	// Format — json или text.
+	Format string `toml:"format" env:"LOG_FORMAT" flag:"log-format"`
+}
+
+type TracingConfig struct {
+	Enabled bool `toml:"enabled" env:"TRACING_ENABLED" flag:"tracing-enabled"`
+	// Exporter — otlp (OTLP/HTTP) или stdout (спаны в stderr, для отладки).
+	Exporter string `toml:"exporter" env:"TRACING_EXPORTER" flag:"tracing-exporter"`
+	// Endpoint — host:port OTLP/HTTP-коллектора.
+	Endpoint string `toml:"endpoint" env:"TRACING_ENDPOINT" flag:"tracing-endpoint"`
+	Insecure bool   `toml:"insecure" env:"TRACING_INSECURE" flag:"tracing-insecure"`
+	// SampleRatio — доля трассируемых корневых запросов, 0..1. Входящий контекст
+	// с решением о сэмплировании имеет приоритет.
+	SampleRatio float64 `toml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" flag:"tracing-sample-ratio"`
+	ServiceName string  `toml:"service_name" env:"TRACING_SERVICE_NAME" flag:"tracing-service-name"`
+}
+
+type AuthConfig struct {
+	// Enabled требует bearer-токен на всех маршрутах, кроме проб, /metrics и Swagger.
+	Enabled bool `toml:"enabled" env:"AUTH_ENABLED" flag:"auth-enabled"`
+}
+
+// OIDCConfig описывает приём JWT (ID/access-токенов) от SSO наравне с API-токенами.
+type OIDCConfig struct {
+	Enabled  bool   `toml:"enabled" env:"OIDC_ENABLED" flag:"oidc-enabled"`
+	Issuer   string `toml:"issuer" env:"OIDC_ISSUER" flag:"oidc-issuer"`
+	Audience string `toml:"audience" env:"OIDC_AUDIENCE" flag:"oidc-audience"`
+	// Ключи подписи берутся из JWKS по URL (с периодическим обновлением) или из
+	// локального файла — для офлайн-окружений и тестов. Задаётся ровно один источник.
+	JWKSURL     string        `toml:"jwks_url" env:"OIDC_JWKS_URL" flag:"oidc-jwks-url"`
+	JWKSFile    string        `toml:"jwks_file" env:"OIDC_JWKS_FILE" flag:"oidc-jwks-file"`
+	JWKSRefresh time.Duration `toml:"jwks_refresh" env:"OIDC_JWKS_REFRESH" flag:"oidc-jwks-refresh"`
+	// UserClaim — claim со значением users.id.
+	UserClaim string `toml:"user_claim" env:"OIDC_USER_CLAIM" flag:"oidc-user-claim"`
+	// RolesClaim — claim со списком ролей: "admin" или "team_lead:<team>".
+	RolesClaim string `toml:"roles_claim" env:"OIDC_ROLES_CLAIM" flag:"oidc-roles-claim"`
+	// Leeway — допустимое расхождение часов при проверке exp/nbf/iat.
+	Leeway time.Duration `toml:"leeway" env:"OIDC_LEEWAY" flag:"oidc-leeway"`
+}
+
+// IdempotencyConfig задаёт хранение ответов на запросы с заголовком Idempotency-Key.
+type IdempotencyConfig struct {
+	// TTL — сколько хранится ответ; повтор с тем же ключом после TTL выполняется заново.
+	TTL time.Duration `toml:"ttl" env:"IDEMPOTENCY_TTL" flag:"idempotency-ttl"`
+	// LockTimeout — через сколько незавершённый запрос (например, после падения
+	// инстанса) перестаёт блокировать повтор с тем же ключом.
+	LockTimeout time.Duration `toml:"lock_timeout" env:"IDEMPOTENCY_LOCK_TIMEOUT" flag:"idempotency-lock-timeout"`
+	// CleanupInterval — период удаления истёкших ключей.
+	CleanupInterval time.Duration `toml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL" flag:"idempotency-cleanup-interval"`
+}
+
+func Default() Config {
+	return Config{
+		HTTP: HTTPConfig{
+			Host:              "0.0.0.0",
+			Port:              8080,
+			ReadTimeout:       30 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      5 * time.Minute,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   30 * time.Second,
+			MaxBodyBytes:      1 << 20,
+			MaxUploadBytes:    256 << 20,
+		},
+		GRPC: GRPCConfig{
+			Enabled:      false,
+			Host:         "0.0.0.0",
+			Port:         9090,
+			Reflection:   true,
+			MaxRecvBytes: 4 << 20,
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:       true,
+			MaxDepth:      8,
+			MaxComplexity: 1000,
+			Introspection: true,
+		},
+		Events: EventsConfig{
+			PollInterval:      2 * time.Second,
+			HeartbeatInterval: 15 * time.Second,
+		},
+		Postgres: PostgresConfig{
+			Port:           5432,
+			SSLMode:        "prefer",
+			ConnectTimeout: 5 * time.Second,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "otlp",
+			Endpoint:    "localhost:4318",
+			SampleRatio: 1,
+			ServiceName: "pr-reviewer-service",
+		},
+		Auth: AuthConfig{
+			Enabled: true,
+		},
+		OIDC: OIDCConfig{
+			JWKSRefresh: time.Hour,
+			UserClaim:   "sub",
+			RolesClaim:  "roles",
+			Leeway:      time.Minute,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:             24 * time.Hour,
+			LockTimeout:     10 * time.Minute,
+			CleanupInterval: time.Hour,
+		},
+	}
+}
+
+// Load собирает конфигурацию из файла, окружения и флагов args (обычно os.Args[1:]).
+// Путь к файлу задаётся флагом --config или переменной CONFIG_PATH; файл по умолчанию
+// (config.toml) может отсутствовать. Возвращает аргументы, оставшиеся после флагов.
+func Load(args []string) (*Config, []string, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	configPath := defaultConfigPath
+	explicitPath := false
+	if v, ok := os.LookupEnv(configPathEnv); ok {
+		configPath, explicitPath = v, true
+	}
+	fs.Func("config", "path to config file (env "+configPathEnv+", default "+defaultConfigPath+")", func(v string) error {
+		configPath, explicitPath = v, true
+		return nil
+	})
+
+	flagValues := make(map[string]string)
+	for _, f := range fields(&cfg) {
+		name := f.flag
+		usage := fmt.Sprintf("overrides %s (env %s)", f.path, f.env)
+		record := func(v string) error {
+			flagValues[name] = v
+			return nil
+		}
+		if f.value.Kind() == reflect.Bool {
+			fs.BoolFunc(name, usage, record)
+		} else {
+			fs.Func(name, usage, record)
+		}
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := toml.DecodeFile(configPath, &cfg); err != nil {
+		if explicitPath || !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, fmt.Errorf("failed to decode config: %s", err)
+		}
+	}
+
+	for _, f := range fields(&cfg) {
+		if v, ok := os.LookupEnv(f.env); ok {
+			if err := f.set(v); err != nil {
+				return nil, nil, fmt.Errorf("env %s: %w", f.env, err)
+			}
+		}
+	}
+
+	for _, f := range fields(&cfg) {
+		if v, ok := flagValues[f.flag]; ok {
+			if err := f.set(v); err != nil {
+				return nil, nil, fmt.Errorf("flag -%s: %w", f.flag, err)
+			}
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return &cfg, fs.Args(), nil
 }
 
-func Load(configPath string) (*Config, error) {
-	var cfg Config
-	_, err := toml.DecodeFile(configPath, &cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode config: %s", err)
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.HTTP.Port < 1 || c.HTTP.Port > 65535 {
+		errs = append(errs, errors.New("http.port must be in 1..65535"))
+	}
+	h := c.HTTP
+	if h.ReadTimeout < 0 || h.ReadHeaderTimeout < 0 || h.WriteTimeout < 0 || h.IdleTimeout < 0 || h.ShutdownDelay < 0 {
+		errs = append(errs, errors.New("http timeouts must not be negative"))
+	}
+	if h.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("http.shutdown_timeout must be positive"))
+	}
+	if h.MaxBodyBytes < 0 || h.MaxUploadBytes < 0 {
+		errs = append(errs, errors.New("http body limits must not be negative"))
+	}
+
+	if g := c.GRPC; g.Enabled {
+		if g.Port < 1 || g.Port > 65535 {
+			errs = append(errs, errors.New("grpc.port must be in 1..65535"))
+		}
+		if g.Port == c.HTTP.Port {
+			errs = append(errs, errors.New("grpc.port must differ from http.port"))
+		}
+		if g.MaxRecvBytes <= 0 {
+			errs = append(errs, errors.New("grpc.max_recv_bytes must be positive"))
+		}
+	}
+
+	if g := c.GraphQL; g.Enabled {
+		if g.MaxDepth <= 0 {
+			errs = append(errs, errors.New("graphql.max_depth must be positive"))
+		}
+		if g.MaxComplexity <= 0 {
+			errs = append(errs, errors.New("graphql.max_complexity must be positive"))
+		}
+	}
+
+	if c.Events.PollInterval <= 0 {
+		errs = append(errs, errors.New("events.poll_interval must be positive"))
+	}
+	if c.Events.HeartbeatInterval <= 0 {
+		errs = append(errs, errors.New("events.heartbeat_interval must be positive"))
+	}
+
+	pg := c.Postgres
+	if pg.Host == "" {
+		errs = append(errs, errors.New("postgres.host is required"))
+	}
+	if pg.Port < 1 || pg.Port > 65535 {
+		errs = append(errs, errors.New("postgres.port must be in 1..65535"))
+	}
+	if pg.User == "" {
+		errs = append(errs, errors.New("postgres.user is required"))
+	}
+	if pg.Database == "" {
+		errs = append(errs, errors.New("postgres.database is required"))
+	}
+
+	switch pg.SSLMode {
+	case "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+	default:
+		errs = append(errs, fmt.Errorf("postgres.sslmode %q is not supported", pg.SSLMode))
+	}
+
+	if pg.MaxConns < 0 || pg.MinConns < 0 {
+		errs = append(errs, errors.New("postgres.max_conns and postgres.min_conns must not be negative"))
+	}
+	if pg.MaxConns > 0 && pg.MinConns > pg.MaxConns {
+		errs = append(errs, errors.New("postgres.min_conns must not exceed postgres.max_conns"))
+	}
+	if pg.MaxConnLifetime < 0 || pg.MaxConnIdleTime < 0 || pg.ConnectTimeout < 0 || pg.StatementTimeout < 0 {
+		errs = append(errs, errors.New("postgres timeouts must not be negative"))
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level %q is not supported", c.Log.Level))
+	}
+	switch c.Log.Format {
+	case "json", "text":
+	default:
+		errs = append(errs, fmt.Errorf("log.format %q is not supported", c.Log.Format))
+	}
+
+	tr := c.Tracing
+	if tr.Enabled {
+		switch tr.Exporter {
+		case "otlp":
+			if tr.Endpoint == "" {
+				errs = append(errs, errors.New("tracing.endpoint is required for otlp exporter"))
+			}
+		case "stdout":
+		default:
+			errs = append(errs, fmt.Errorf("tracing.exporter %q is not supported", tr.Exporter))
+		}
+		if tr.SampleRatio < 0 || tr.SampleRatio > 1 {
+			errs = append(errs, errors.New("tracing.sample_ratio must be in 0..1"))
+		}
+		if tr.ServiceName == "" {
+			errs = append(errs, errors.New("tracing.service_name is required"))
+		}
+	}
+
+	oidc := c.OIDC
+	if oidc.Enabled {
+		if oidc.Issuer == "" {
+			errs = append(errs, errors.New("oidc.issuer is required"))
+		}
+		if oidc.Audience == "" {
+			errs = append(errs, errors.New("oidc.audience is required"))
+		}
+		if (oidc.JWKSURL == "") == (oidc.JWKSFile == "") {
+			errs = append(errs, errors.New("exactly one of oidc.jwks_url and oidc.jwks_file is required"))
+		}
+		if oidc.UserClaim == "" {
+			errs = append(errs, errors.New("oidc.user_claim is required"))
+		}
+		if oidc.JWKSRefresh < 0 || oidc.Leeway < 0 {
+			errs = append(errs, errors.New("oidc durations must not be negative"))
+		}
+	}
+
+	idem := c.Idempotency
+	if idem.TTL <= 0 || idem.LockTimeout <= 0 || idem.CleanupInterval <= 0 {
+		errs = append(errs, errors.New("idempotency durations must be positive"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// LogValue скрывает пароль, чтобы конфигурацию можно было писать в лог.
+func (c PostgresConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("host", c.Host),
+		slog.Int("port", c.Port),
+		slog.String("user", c.User),
+		slog.String("database", c.Database),
+		slog.String("sslmode", c.SSLMode),
+	)
+}
+
+// ConnString собирает строку подключения с учётом sslmode и connect_timeout.
+func (c PostgresConfig) ConnString() string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(c.User, c.Password),
+		Host:   fmt.Sprintf("%s:%d", c.Host, c.Port),
+		Path:   "/" + c.Database,
+	}
+
+	q := url.Values{}
+	q.Set("sslmode", c.SSLMode)
+	if c.ConnectTimeout > 0 {
+		// connect_timeout задаётся в целых секундах; округляем вверх, чтобы
+		// доли секунды не превратились в 0 — «ждать бесконечно».
+		seconds := (c.ConnectTimeout + time.Second - 1) / time.Second
+		q.Set("connect_timeout", strconv.FormatInt(int64(seconds), 10))
 	}
+	u.RawQuery = q.Encode()
 
-	return &cfg, nil
+	return u.String()
 }
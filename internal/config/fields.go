@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	path  string
+	env   string
+	flag  string
+	value reflect.Value
+}
+
+// fields возвращает все переопределяемые поля конфигурации (с тегами env и flag).
+func fields(cfg *Config) []field {
+	var res []field
+	collectFields(reflect.ValueOf(cfg).Elem(), "", &res)
+	return res
+}
+
+func collectFields(v reflect.Value, prefix string, res *[]field) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		path := strings.TrimPrefix(prefix+"."+sf.Tag.Get("toml"), ".")
+
+		if sf.Type.Kind() == reflect.Struct {
+			collectFields(v.Field(i), path, res)
+			continue
+		}
+
+		env, flagName := sf.Tag.Get("env"), sf.Tag.Get("flag")
+		if env == "" || flagName == "" {
+			continue
+		}
+
+		*res = append(*res, field{path: path, env: env, flag: flagName, value: v.Field(i)})
+	}
+}
+
+func (f field) set(s string) error {
+	if f.value.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("%s: invalid duration %q", f.path, s)
+		}
+		f.value.SetInt(int64(d))
+		return nil
+	}
+
+	switch f.value.Kind() {
+	case reflect.String:
+		f.value.SetString(s)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, f.value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: invalid integer %q", f.path, s)
+		}
+		f.value.SetInt(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid number %q", f.path, s)
+		}
+		f.value.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", f.path, s)
+		}
+		f.value.SetBool(b)
+	default:
+		return fmt.Errorf("%s: unsupported type %s", f.path, f.value.Type())
+	}
+
+	return nil
+}
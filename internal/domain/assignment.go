@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Assignment — назначение ревьювера на PR.
+type Assignment struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	Status          string
+	ReviewerID      string
+	ReviewerTeam    string
+	AssignedAt      time.Time
+	ReviewedAt      *time.Time
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditEntry — запись журнала аудита об изменении сущности.
+type AuditEntry struct {
+	ID         int64
+	OccurredAt time.Time
+	// Actor — Subject вызывающего: user_id, token:<id> или system.
+	Actor      string
+	Action     string
+	EntityType string
+	EntityID   string
+	// Before и After — JSON-снимки сущности; nil, если снимка нет (например, до создания).
+	Before    json.RawMessage
+	After     json.RawMessage
+	RequestID string
+	// TxID — транзакция, в которой сделана запись; задаёт порядок ленты событий.
+	TxID int64
+}
+
+// AuditFilter ограничивает выборку журнала. Пустые поля не фильтруют.
+// Записи отдаются от новых к старым; BeforeID — курсор следующей страницы.
+type AuditFilter struct {
+	Actor      string
+	Action     string
+	EntityType string
+	EntityID   string
+	From       *time.Time
+	To         *time.Time
+	BeforeID   int64
+	Limit      int
+}
@@ -0,0 +1,20 @@
+package domain
+
+// Backup — полное содержимое БД для восстановления в пустую базу.
+type Backup struct {
+	Teams        []string
+	Users        []User
+	UserRoles    []RoleAssignment
+	PullRequests []PullRequest
+	Reviewers    []Assignment
+	ActivityLog  []ActivityChange
+}
+
+type RestoreResult struct {
+	TeamsCount        int
+	UsersCount        int
+	UserRolesCount    int
+	PullRequestsCount int
+	ReviewersCount    int
+	ActivityLogCount  int
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord — сохранённый результат запроса с заголовком Idempotency-Key.
+// Ключи разных вызывающих не пересекаются: Owner — Subject вызывающего.
+type IdempotencyRecord struct {
+	Owner string
+	Key   string
+	// RequestHash — SHA-256 метода, пути с query и тела запроса.
+	RequestHash []byte
+	// StatusCode, Headers и Body заполняются после выполнения запроса.
+	StatusCode  int
+	Headers     map[string]string
+	Body        []byte
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   time.Time
+}
+
+// Completed сообщает, что ответ уже сохранён и его можно вернуть повторно.
+func (r *IdempotencyRecord) Completed() bool {
+	return r.CompletedAt != nil
+}
@@ -0,0 +1,41 @@
+package domain
+
+// ImportBatch — набор команд, участников и исторических PR для массовой загрузки.
+type ImportBatch struct {
+	Teams        []ImportTeam
+	PullRequests []ImportPullRequest
+	// Errors — ошибки значений, найденные при разборе (CSV); строка с такой
+	// ошибкой в пакет либо не входит, либо входит без ошибочного поля.
+	Errors []ImportRowError
+}
+
+type ImportTeam struct {
+	Row int
+	// MemberRows — строки участников в CSV; для JSON/YAML пусто, ошибки участников относятся к Row.
+	MemberRows []int
+	Team       Team
+}
+
+type ImportPullRequest struct {
+	Row         int
+	PullRequest PullRequest
+}
+
+// ImportRowError описывает ошибку конкретной строки входного документа.
+// Row — номер строки CSV или порядковый номер (с 1) элемента в секции JSON/YAML.
+type ImportRowError struct {
+	Section string
+	Row     int
+	Field   string
+	Message string
+}
+
+type ImportResult struct {
+	DryRun            bool
+	Applied           bool
+	TeamsCount        int
+	UsersCount        int
+	PullRequestsCount int
+	ReviewersCount    int
+	Errors            []ImportRowError
+}
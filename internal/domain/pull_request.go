@@ -17,4 +17,16 @@ type PullRequest struct {
 	ReviewersID       []string
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	// Version увеличивается при каждом изменении PR или его ревьюверов и
+	// отдаётся клиентам как ETag.
+	Version int64
+}
+
+// PullRequestFilter ограничивает список PR. Пустые поля не фильтруют; TeamName —
+// команда автора. PR отдаются от новых к старым, не больше Limit.
+type PullRequestFilter struct {
+	TeamName string
+	AuthorID string
+	Status   string
+	Limit    int
 }
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// ReviewEventType — тип события ленты ревью.
+type ReviewEventType string
+
+const (
+	ReviewEventCreated    ReviewEventType = "pr.created"
+	ReviewEventAssigned   ReviewEventType = "pr.assigned"
+	ReviewEventReassigned ReviewEventType = "pr.reassigned"
+	ReviewEventMerged     ReviewEventType = "pr.merged"
+)
+
+// ReviewEvent — событие ленты ревью. События строятся по записям журнала аудита:
+// TxID и ID — транзакция и идентификатор записи, Seq — номер события внутри неё
+// (создание PR даёт pr.created и по pr.assigned на каждого ревьювера).
+type ReviewEvent struct {
+	TxID       int64
+	ID         int64
+	Seq        int
+	Last       bool
+	Type       ReviewEventType
+	OccurredAt time.Time
+	Actor      string
+
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	// TeamName — команда автора PR.
+	TeamName  string
+	Status    string
+	Reviewers []string
+	// ReviewerID — назначенный ревьювер (pr.assigned).
+	ReviewerID string
+	// OldReviewerID и NewReviewerID заполняются для pr.reassigned.
+	OldReviewerID string
+	NewReviewerID string
+}
+
+// ReviewEventCursor — позиция в ленте, упорядоченной по (TxID, ID): записи до
+// (TxID, ID) и события записи ID с номером не больше Seq уже получены. Seq = -1 —
+// вся запись ID получена.
+type ReviewEventCursor struct {
+	TxID int64
+	ID   int64
+	Seq  int
+}
+
+// ReviewEventFilter ограничивает ленту. Пустые поля не фильтруют. UserID совпадает
+// с автором или любым ревьювером события.
+type ReviewEventFilter struct {
+	TeamName string
+	UserID   string
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// Permission — право на действие. Права выдаются ролям в таблице role_permissions.
+type Permission string
+
+const (
+	PermTeamsManage        Permission = "teams:manage"
+	PermUsersManage        Permission = "users:manage"
+	PermUsersManageTeam    Permission = "users:manage:team"
+	PermPullRequestsManage Permission = "pull_requests:manage"
+	// PermPullRequestsOwn — создание и merge PR, автором которых является сам пользователь.
+	PermPullRequestsOwn Permission = "pull_requests:own"
+	PermReassignTeam    Permission = "reviewers:reassign:team"
+	// PermReassignSelf — снять с себя назначение ревьювером.
+	PermReassignSelf Permission = "reviewers:reassign:self"
+	// PermReviewsSelf — отметить собственное ревью выполненным.
+	PermReviewsSelf  Permission = "reviews:self"
+	PermDataManage   Permission = "data:manage"
+	PermTokensManage Permission = "tokens:manage"
+	PermRolesManage  Permission = "roles:manage"
+	PermAuditRead    Permission = "audit:read"
+)
+
+const (
+	RoleAdmin    = "admin"
+	RoleTeamLead = "team_lead"
+	RoleMember   = "member"
+)
+
+type Role struct {
+	Name        string
+	Description string
+	IsDefault   bool
+	Permissions []Permission
+}
+
+// RoleAssignment — роль пользователя. Пустой TeamName означает глобальную роль.
+type RoleAssignment struct {
+	UserID    string
+	Role      string
+	TeamName  string
+	CreatedAt time.Time
+}
+
+// Grant — право, действующее в команде TeamName или везде, если TeamName пуст.
+type Grant struct {
+	Permission Permission
+	TeamName   string
+}
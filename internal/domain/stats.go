@@ -1,7 +1,107 @@
 package domain
 
+import "time"
+
 type ReviewerStats struct {
 	UserID        string
 	UserName      string
 	AssignedCount int64
+	OpenCount     int64
+	MergedCount   int64
+}
+
+type TeamStats struct {
+	TeamName      string
+	AssignedCount int64
+	MergedCount   int64
+	MembersCount  int64
+	ActiveMembers int64
+}
+
+// StatsFilter ограничивает выборку статистики. Пустые поля не фильтруют.
+type StatsFilter struct {
+	From     *time.Time
+	To       *time.Time
+	TeamName string
+	Status   string
+}
+
+// DurationStats — перцентили длительностей в секундах.
+type DurationStats struct {
+	Count int64
+	P50   float64
+	P90   float64
+	P99   float64
+}
+
+type TeamLatency struct {
+	TeamName string
+	DurationStats
+}
+
+type AuthorLatency struct {
+	UserID   string
+	UserName string
+	TeamName string
+	DurationStats
+}
+
+type ReviewerLatency struct {
+	UserID   string
+	UserName string
+	DurationStats
+}
+
+type LatencyTrendBucket struct {
+	WeekStart time.Time
+	DurationStats
+}
+
+type LatencyReport struct {
+	TimeToMergeByTeam      []TeamLatency
+	TimeToMergeByAuthor    []AuthorLatency
+	TimeToFirstReview      []ReviewerLatency
+	TimeToMergeWeeklyTrend []LatencyTrendBucket
+}
+
+type MemberLoad struct {
+	UserID        string
+	UserName      string
+	TeamName      string
+	IsActive      bool
+	AssignedCount int64
+}
+
+// ActivityChange — запись из журнала смены is_active пользователя.
+type ActivityChange struct {
+	UserID    string
+	IsActive  bool
+	ChangedAt time.Time
+}
+
+type LoadOutlier string
+
+const (
+	LoadOverloaded  LoadOutlier = "OVERLOADED"
+	LoadUnderloaded LoadOutlier = "UNDERLOADED"
+)
+
+type MemberFairness struct {
+	UserID        string
+	UserName      string
+	AssignedCount int64
+	ActiveRatio   float64
+	Share         float64
+	ExpectedShare float64
+	LoadRatio     float64
+	Outlier       LoadOutlier
+}
+
+type TeamFairness struct {
+	TeamName      string
+	From          time.Time
+	To            time.Time
+	TotalAssigned int64
+	Gini          float64
+	Members       []MemberFairness
 }
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// APIToken — токен доступа к API. В БД хранится только хэш, сам токен
+// показывается один раз при создании.
+type APIToken struct {
+	ID         string
+	Name       string
+	UserID     string
+	IsAdmin    bool
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// Active сообщает, можно ли аутентифицироваться токеном в момент now.
+func (t *APIToken) Active(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
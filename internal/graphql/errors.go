@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"errors"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
+)
+
+// Коды extensions.code для ошибок документа; ошибки резолверов получают код apperror.
+const (
+	codeParseFailed      = "GRAPHQL_PARSE_FAILED"
+	codeValidationFailed = "GRAPHQL_VALIDATION_FAILED"
+)
+
+// requestError — ошибка запроса целиком, до выполнения.
+func requestError(code, message string) *gqlerrors.FormattedError {
+	return &gqlerrors.FormattedError{
+		Message:    message,
+		Locations:  []location.SourceLocation{},
+		Extensions: map[string]any{"code": code},
+	}
+}
+
+// withCode проставляет extensions.code ошибкам разбора и валидации документа.
+func withCode(errs []gqlerrors.FormattedError, code string) []gqlerrors.FormattedError {
+	for i := range errs {
+		errs[i].Extensions = map[string]any{"code": code}
+	}
+	return errs
+}
+
+// fieldErrors переводит ошибки резолверов в ответ: код и детали берутся из
+// apperror, внутренние ошибки скрываются за «internal error», как в WriteError.
+// Собственные ошибки исполнителя (например, null в non-null поле) остаются как есть.
+// Внутренние ошибки возвращаются отдельно, чтобы их увидели access-лог и спан.
+func fieldErrors(errs []gqlerrors.FormattedError) ([]gqlerrors.FormattedError, error) {
+	var internal []error
+
+	for i := range errs {
+		cause := originalError(errs[i])
+		if cause == nil {
+			continue
+		}
+
+		appErr := apperror.From(cause)
+		if appErr == nil || appErr.Code == apperror.CodeInternal {
+			internal = append(internal, cause)
+			errs[i].Message = "internal error"
+			errs[i].Extensions = map[string]any{"code": string(apperror.CodeInternal)}
+			continue
+		}
+
+		errs[i].Message = appErr.Message
+		errs[i].Extensions = map[string]any{"code": string(appErr.Code)}
+		if len(appErr.Details) > 0 {
+			details := make([]response.ErrorDetail, 0, len(appErr.Details))
+			for _, d := range appErr.Details {
+				details = append(details, response.ErrorDetail{Field: d.Field, Rule: d.Rule, Message: d.Message})
+			}
+			errs[i].Extensions["details"] = details
+		}
+	}
+
+	return errs, errors.Join(internal...)
+}
+
+// originalError разворачивает обёртки gqlerrors до ошибки резолвера. nil — ошибка
+// возникла в самом исполнителе.
+func originalError(err error) error {
+	for {
+		switch e := err.(type) {
+		case gqlerrors.FormattedError:
+			next := e.OriginalError()
+			if next == nil {
+				return nil
+			}
+			err = next
+		case *gqlerrors.Error:
+			if e.OriginalError == nil {
+				return nil
+			}
+			err = e.OriginalError
+		default:
+			return err
+		}
+	}
+}
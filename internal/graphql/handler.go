@@ -0,0 +1,133 @@
+// Package graphql реализует эндпоинт /graphql для дашбордов: чтение команд,
+// пользователей, PR с ревьюверами и статистики одним запросом. Вложенные поля
+// загружаются пачками через loaders, а глубина и сложность запроса проверяются
+// до выполнения.
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Request — тело POST /graphql; в GET те же поля передаются query-параметрами,
+// variables — строкой JSON.
+type Request struct {
+	Query         string         `json:"query" example:"{ teams { name members { id name } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response — ответ GraphQL. data отсутствует, если запрос отклонён до выполнения.
+type Response struct {
+	Data   any                        `json:"data,omitempty" swaggertype:"object"`
+	Errors []gqlerrors.FormattedError `json:"errors,omitempty" swaggertype:"array,object"`
+}
+
+type Handler struct {
+	schema    graphql.Schema
+	dashboard service.DashboardService
+	cfg       config.GraphQLConfig
+}
+
+// NewHandler строит схему; ошибка схемы — ошибка программы, поэтому паника.
+func NewHandler(cfg config.GraphQLConfig, dashboard service.DashboardService, stats service.StatsService) *Handler {
+	schema, err := newSchema(dashboard, stats)
+	if err != nil {
+		panic("graphql: build schema: " + err.Error())
+	}
+	return &Handler{schema: schema, dashboard: dashboard, cfg: cfg}
+}
+
+// ServeHTTP godoc
+// @Summary      GraphQL для дашбордов
+// @Description  Выполняет GraphQL-запрос к командам, пользователям, PR, ревьюверам и статистике. Схема доступна через интроспекцию (graphql.introspection).
+// @Description  Ошибки документа и превышение graphql.max_depth / graphql.max_complexity возвращаются с кодом 400 в поле errors, ошибки полей — с кодом 200 рядом с частичными data. Код ошибки — в errors[].extensions.code.
+// @Tags         GraphQL
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      Request  true  "Запрос"
+// @Success      200  {object}  Response
+// @Failure      400  {object}  Response                "GRAPHQL_PARSE_FAILED, GRAPHQL_VALIDATION_FAILED, QUERY_TOO_DEEP, QUERY_TOO_COMPLEX, INTROSPECTION_DISABLED"
+// @Failure      401  {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Router       /graphql [post]
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				response.WriteError(w, validation.Invalid("variables", validation.RuleFormat, "variables must be a JSON object"))
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := request.DecodeJSON(r, &req); err != nil {
+			response.WriteError(w, err)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Query == "" {
+		response.WriteError(w, validation.Missing("query"))
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, Response{Errors: withCode(gqlerrors.FormatErrors(err), codeParseFailed)})
+		return
+	}
+
+	if res := graphql.ValidateDocument(&h.schema, doc, nil); !res.IsValid {
+		writeResponse(w, http.StatusBadRequest, Response{Errors: withCode(res.Errors, codeValidationFailed)})
+		return
+	}
+
+	if limitErr := h.checkLimits(doc, req.OperationName, req.Variables); limitErr != nil {
+		writeResponse(w, http.StatusBadRequest, Response{Errors: []gqlerrors.FormattedError{*limitErr}})
+		return
+	}
+
+	ctx := withLoaders(r.Context(), newLoaders(h.dashboard))
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+
+	errs, internalErr := fieldErrors(result.Errors)
+	if internalErr != nil {
+		response.RecordError(w, internalErr)
+	}
+
+	writeResponse(w, http.StatusOK, Response{Data: result.Data, Errors: errs})
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,207 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// unboundedListCost — множитель для списка без аргумента limit: такой список
+// оценивается по наибольшему допустимому limit.
+const unboundedListCost = service.MaxDashboardLimit
+
+const (
+	codeQueryTooDeep          = "QUERY_TOO_DEEP"
+	codeQueryTooComplex       = "QUERY_TOO_COMPLEX"
+	codeIntrospectionDisabled = "INTROSPECTION_DISABLED"
+)
+
+// limits считает глубину и сложность операции по документу до выполнения. Стоимость
+// поля — 1 плюс стоимость вложенных полей, умноженная для списков на limit. Служебные
+// поля интроспекции в подсчёт не входят: их глубина ограничена самой схемой.
+type limits struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+
+	depth         int
+	introspection bool
+}
+
+// checkLimits возвращает ошибку, если операция превышает ограничения cfg. Документ
+// к этому моменту уже прошёл валидацию, поэтому циклов во фрагментах нет.
+func (h *Handler) checkLimits(doc *ast.Document, operationName string, variables map[string]any) *gqlerrors.FormattedError {
+	op := operation(doc, operationName)
+	if op == nil {
+		// Выбор операции проверит исполнитель и вернёт понятную ошибку.
+		return nil
+	}
+
+	l := &limits{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: make(map[string]any, len(op.VariableDefinitions)),
+	}
+	for _, vd := range op.VariableDefinitions {
+		name := vd.Variable.Name.Value
+		if v, ok := variables[name]; ok {
+			l.variables[name] = v
+		} else if v, ok := vd.DefaultValue.(*ast.IntValue); ok {
+			l.variables[name], _ = strconv.Atoi(v.Value)
+		}
+	}
+	for _, def := range doc.Definitions {
+		if f, ok := def.(*ast.FragmentDefinition); ok {
+			l.fragments[f.Name.Value] = f
+		}
+	}
+
+	root := h.schema.QueryType()
+	complexity := l.cost(op.SelectionSet, root, 1)
+
+	if l.introspection && !h.cfg.Introspection {
+		return requestError(codeIntrospectionDisabled, "introspection is disabled")
+	}
+	if l.depth > h.cfg.MaxDepth {
+		return requestError(codeQueryTooDeep, fmt.Sprintf("query depth %d exceeds limit of %d", l.depth, h.cfg.MaxDepth))
+	}
+	if complexity > h.cfg.MaxComplexity {
+		return requestError(codeQueryTooComplex, fmt.Sprintf("query complexity exceeds limit of %d", h.cfg.MaxComplexity))
+	}
+	return nil
+}
+
+func (l *limits) cost(set *ast.SelectionSet, parent *graphql.Object, depth int) int {
+	if set == nil {
+		return 0
+	}
+
+	total := 0
+	for _, sel := range set.Selections {
+		switch s := sel.(type) {
+		case *ast.Field:
+			total = saturatingAdd(total, l.fieldCost(s, parent, depth))
+		case *ast.InlineFragment:
+			total = saturatingAdd(total, l.cost(s.SelectionSet, parent, depth))
+		case *ast.FragmentSpread:
+			if f, ok := l.fragments[s.Name.Value]; ok {
+				total = saturatingAdd(total, l.cost(f.SelectionSet, parent, depth))
+			}
+		}
+	}
+	return total
+}
+
+func (l *limits) fieldCost(f *ast.Field, parent *graphql.Object, depth int) int {
+	name := f.Name.Value
+	if strings.HasPrefix(name, "__") {
+		if name != "__typename" {
+			l.introspection = true
+		}
+		return 0
+	}
+
+	def, ok := parent.Fields()[name]
+	if !ok {
+		return 0
+	}
+	l.depth = max(l.depth, depth)
+
+	child, isList := unwrapType(def.Type)
+	obj, ok := child.(*graphql.Object)
+	if !ok {
+		return 1
+	}
+
+	childCost := l.cost(f.SelectionSet, obj, depth+1)
+	if isList {
+		childCost = saturatingMul(childCost, l.listSize(f, def))
+	}
+	return saturatingAdd(1, childCost)
+}
+
+// listSize — сколько элементов может вернуть список: limit из запроса или
+// переменной, иначе значение limit по умолчанию, иначе unboundedListCost.
+func (l *limits) listSize(f *ast.Field, def *graphql.FieldDefinition) int {
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(v.Value); err == nil {
+				return max(n, 0)
+			}
+		case *ast.Variable:
+			switch n := l.variables[v.Name.Value].(type) {
+			case float64:
+				return max(int(min(n, math.MaxInt32)), 0)
+			case int:
+				return max(n, 0)
+			}
+		}
+	}
+
+	for _, arg := range def.Args {
+		if arg.Name() == "limit" {
+			if n, ok := arg.DefaultValue.(int); ok {
+				return n
+			}
+		}
+	}
+	return unboundedListCost
+}
+
+func unwrapType(t graphql.Type) (graphql.Type, bool) {
+	isList := false
+	for {
+		switch w := t.(type) {
+		case *graphql.NonNull:
+			t = w.OfType
+		case *graphql.List:
+			isList = true
+			t = w.OfType
+		default:
+			return t, isList
+		}
+	}
+}
+
+func operation(doc *ast.Document, name string) *ast.OperationDefinition {
+	var found *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if name == "" {
+			if found != nil {
+				return nil
+			}
+			found = op
+			continue
+		}
+		if op.Name != nil && op.Name.Value == name {
+			return op
+		}
+	}
+	return found
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt32-b {
+		return math.MaxInt32
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt32/a {
+		return math.MaxInt32
+	}
+	return a * b
+}
@@ -0,0 +1,180 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+// loader откладывает загрузку по ключу: load только запоминает ключ, а первый
+// вызов возвращённой функции загружает все накопленные ключи одним вызовом fetch.
+// Исполнитель вызывает отложенные резолверы уровень за уровнем, поэтому на уровень
+// запроса приходится один fetch, а не по одному на объект. Результаты кешируются
+// на время запроса.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]struct{}
+	results map[K]loaderResult[V]
+}
+
+type loaderResult[V any] struct {
+	value V
+	found bool
+	err   error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		queued:  make(map[K]struct{}),
+		results: make(map[K]loaderResult[V]),
+	}
+}
+
+// load ставит key в очередь. found = false, если по ключу ничего не нашлось.
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, bool, error) {
+	l.mu.Lock()
+	l.enqueue(key)
+	l.mu.Unlock()
+
+	return func() (V, bool, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if _, ok := l.results[key]; !ok {
+			l.flush(ctx)
+		}
+		r := l.results[key]
+		return r.value, r.found, r.err
+	}
+}
+
+// loadMany ставит в очередь все keys; ненайденные ключи пропускаются.
+func (l *loader[K, V]) loadMany(ctx context.Context, keys []K) func() ([]V, error) {
+	thunks := make([]func() (V, bool, error), 0, len(keys))
+	for _, key := range keys {
+		thunks = append(thunks, l.load(ctx, key))
+	}
+
+	return func() ([]V, error) {
+		values := make([]V, 0, len(thunks))
+		for _, thunk := range thunks {
+			v, found, err := thunk()
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				values = append(values, v)
+			}
+		}
+		return values, nil
+	}
+}
+
+func (l *loader[K, V]) enqueue(key K) {
+	if _, ok := l.results[key]; ok {
+		return
+	}
+	if _, ok := l.queued[key]; ok {
+		return
+	}
+	l.queued[key] = struct{}{}
+	l.pending = append(l.pending, key)
+}
+
+// flush загружает накопленные ключи. Ошибка fetch достаётся всем ключам пачки.
+func (l *loader[K, V]) flush(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	clear(l.queued)
+
+	values, err := l.fetch(ctx, keys)
+	for _, key := range keys {
+		v, found := values[key]
+		l.results[key] = loaderResult[V]{value: v, found: found, err: err}
+	}
+}
+
+// loaders — загрузчики одного запроса.
+type loaders struct {
+	teams        *loader[string, domain.Team]
+	users        *loader[string, domain.User]
+	pullRequests *loader[string, domain.PullRequest]
+	reviews      *loader[reviewsKey, []domain.PullRequest]
+}
+
+// reviewsKey — назначения пользователя с аргументами поля reviews.
+type reviewsKey struct {
+	userID string
+	status string
+	limit  int
+}
+
+func newLoaders(dashboard service.DashboardService) *loaders {
+	return &loaders{
+		teams: newLoader(func(ctx context.Context, names []string) (map[string]domain.Team, error) {
+			teams, err := dashboard.TeamsByNames(ctx, names)
+			return index(teams, func(t domain.Team) string { return t.Name }), err
+		}),
+		users: newLoader(func(ctx context.Context, ids []string) (map[string]domain.User, error) {
+			users, err := dashboard.UsersByIDs(ctx, ids)
+			return index(users, func(u domain.User) string { return u.ID }), err
+		}),
+		pullRequests: newLoader(func(ctx context.Context, ids []string) (map[string]domain.PullRequest, error) {
+			prs, err := dashboard.PullRequestsByIDs(ctx, ids)
+			return index(prs, func(pr domain.PullRequest) string { return pr.PullRequestID }), err
+		}),
+		reviews: newLoader(func(ctx context.Context, keys []reviewsKey) (map[reviewsKey][]domain.PullRequest, error) {
+			return loadReviews(ctx, dashboard, keys)
+		}),
+	}
+}
+
+// loadReviews загружает назначения одним запросом на каждый набор аргументов
+// reviews; обычно он в запросе один.
+func loadReviews(ctx context.Context, dashboard service.DashboardService, keys []reviewsKey) (map[reviewsKey][]domain.PullRequest, error) {
+	var args []reviewsKey
+	userIDs := make(map[reviewsKey][]string)
+	for _, k := range keys {
+		a := reviewsKey{status: k.status, limit: k.limit}
+		if _, ok := userIDs[a]; !ok {
+			args = append(args, a)
+		}
+		userIDs[a] = append(userIDs[a], k.userID)
+	}
+
+	res := make(map[reviewsKey][]domain.PullRequest, len(keys))
+	for _, a := range args {
+		reviews, err := dashboard.ReviewsByUserIDs(ctx, userIDs[a], a.status, a.limit)
+		if err != nil {
+			return nil, err
+		}
+		for userID, prs := range reviews {
+			res[reviewsKey{userID: userID, status: a.status, limit: a.limit}] = prs
+		}
+	}
+	return res, nil
+}
+
+func index[V any](values []V, key func(V) string) map[string]V {
+	m := make(map[string]V, len(values))
+	for _, v := range values {
+		m[key(v)] = v
+	}
+	return m
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,428 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+	"github.com/graphql-go/graphql"
+)
+
+// defaultListLimit — значение аргумента limit по умолчанию у списков.
+const defaultListLimit = service.DefaultDashboardLimit
+
+// resolvers хранит сервисы для корневых полей; вложенные поля загружаются через loaders.
+type resolvers struct {
+	dashboard service.DashboardService
+	stats     service.StatsService
+}
+
+// newSchema описывает схему: команды, пользователи, PR с ревьюверами и статистика.
+// Связи между объектами (автор, ревьюверы, команда, назначения) загружаются через
+// loaders, поэтому вложенные поля не порождают запрос на каждый объект.
+func newSchema(dashboard service.DashboardService, stats service.StatsService) (graphql.Schema, error) {
+	r := &resolvers{dashboard: dashboard, stats: stats}
+
+	statusEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestStatus",
+		Values: graphql.EnumValueConfigMap{
+			string(domain.PRStatusOpen):   {Value: string(domain.PRStatusOpen)},
+			string(domain.PRStatusMerged): {Value: string(domain.PRStatusMerged)},
+		},
+	})
+
+	teamType := graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Team",
+		Fields: graphql.Fields{},
+	})
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name:   "User",
+		Fields: graphql.Fields{},
+	})
+	prType := graphql.NewObject(graphql.ObjectConfig{
+		Name:   "PullRequest",
+		Fields: graphql.Fields{},
+	})
+
+	limitArg := &graphql.ArgumentConfig{
+		Type:         graphql.Int,
+		DefaultValue: defaultListLimit,
+		Description:  "Сколько элементов вернуть, 1..100.",
+	}
+
+	teamType.AddFieldConfig("name", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.String),
+		Resolve: field(func(t domain.Team) any { return t.Name }),
+	})
+	teamType.AddFieldConfig("members", &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+		Args: graphql.FieldConfigArgument{
+			"limit": limitArg,
+		},
+		Resolve: r.teamMembers,
+	})
+
+	userType.AddFieldConfig("id", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.ID),
+		Resolve: field(func(u domain.User) any { return u.ID }),
+	})
+	userType.AddFieldConfig("name", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.String),
+		Resolve: field(func(u domain.User) any { return u.Name }),
+	})
+	userType.AddFieldConfig("isActive", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.Boolean),
+		Resolve: field(func(u domain.User) any { return u.IsActive }),
+	})
+	userType.AddFieldConfig("team", &graphql.Field{
+		Type:    teamType,
+		Resolve: r.userTeam,
+	})
+	userType.AddFieldConfig("reviews", &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(prType))),
+		Description: "PR, на которые пользователь назначен ревьювером, от новых к старым.",
+		Args: graphql.FieldConfigArgument{
+			"status": {Type: statusEnum},
+			"limit":  limitArg,
+		},
+		Resolve: r.userReviews,
+	})
+
+	prType.AddFieldConfig("id", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.ID),
+		Resolve: field(func(pr domain.PullRequest) any { return pr.PullRequestID }),
+	})
+	prType.AddFieldConfig("name", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.String),
+		Resolve: field(func(pr domain.PullRequest) any { return pr.PullRequestName }),
+	})
+	prType.AddFieldConfig("status", &graphql.Field{
+		Type:    graphql.NewNonNull(statusEnum),
+		Resolve: field(func(pr domain.PullRequest) any { return pr.PullRequestStatus }),
+	})
+	prType.AddFieldConfig("createdAt", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.DateTime),
+		Resolve: field(func(pr domain.PullRequest) any { return pr.CreatedAt }),
+	})
+	prType.AddFieldConfig("mergedAt", &graphql.Field{
+		Type: graphql.DateTime,
+		Resolve: field(func(pr domain.PullRequest) any {
+			if pr.MergedAt == nil {
+				return nil
+			}
+			return *pr.MergedAt
+		}),
+	})
+	prType.AddFieldConfig("version", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.Int),
+		Resolve: field(func(pr domain.PullRequest) any { return pr.Version }),
+	})
+	prType.AddFieldConfig("author", &graphql.Field{
+		Type:    userType,
+		Resolve: r.pullRequestAuthor,
+	})
+	prType.AddFieldConfig("reviewers", &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+		Args: graphql.FieldConfigArgument{
+			"limit": limitArg,
+		},
+		Resolve: r.pullRequestReviewers,
+	})
+
+	reviewerStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReviewerStats",
+		Fields: graphql.Fields{
+			"user": {
+				Type:    userType,
+				Resolve: r.reviewerStatsUser,
+			},
+			"assignedCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.ReviewerStats) any { return s.AssignedCount }),
+			},
+			"openCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.ReviewerStats) any { return s.OpenCount }),
+			},
+			"mergedCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.ReviewerStats) any { return s.MergedCount }),
+			},
+		},
+	})
+
+	teamStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TeamStats",
+		Fields: graphql.Fields{
+			"team": {
+				Type:    teamType,
+				Resolve: r.teamStatsTeam,
+			},
+			"assignedCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.TeamStats) any { return s.AssignedCount }),
+			},
+			"mergedCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.TeamStats) any { return s.MergedCount }),
+			},
+			"membersCount": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.TeamStats) any { return s.MembersCount }),
+			},
+			"activeMembers": {
+				Type:    graphql.NewNonNull(graphql.Int),
+				Resolve: field(func(s domain.TeamStats) any { return s.ActiveMembers }),
+			},
+		},
+	})
+
+	statsFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "StatsFilter",
+		Description: "Фильтр статистики, как у /stats/*: период по created_at PR, команда и статус.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"from":   {Type: graphql.DateTime},
+			"to":     {Type: graphql.DateTime},
+			"team":   {Type: graphql.String},
+			"status": {Type: statusEnum},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": {
+				Type: teamType,
+				Args: graphql.FieldConfigArgument{
+					"name": {Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.team,
+			},
+			"teams": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamType))),
+				Args: graphql.FieldConfigArgument{
+					"limit": limitArg,
+				},
+				Resolve: r.teams,
+			},
+			"user": {
+				Type: userType,
+				Args: graphql.FieldConfigArgument{
+					"id": {Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.user,
+			},
+			"pullRequest": {
+				Type: prType,
+				Args: graphql.FieldConfigArgument{
+					"id": {Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.pullRequest,
+			},
+			"pullRequests": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(prType))),
+				Description: "PR от новых к старым. team — команда автора.",
+				Args: graphql.FieldConfigArgument{
+					"team":   {Type: graphql.String},
+					"author": {Type: graphql.ID},
+					"status": {Type: statusEnum},
+					"limit":  limitArg,
+				},
+				Resolve: r.pullRequests,
+			},
+			"reviewerStats": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(reviewerStatsType))),
+				Args: graphql.FieldConfigArgument{
+					"filter": {Type: statsFilterType},
+					"limit":  limitArg,
+				},
+				Resolve: r.reviewerStats,
+			},
+			"teamStats": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamStatsType))),
+				Args: graphql.FieldConfigArgument{
+					"filter": {Type: statsFilterType},
+					"limit":  limitArg,
+				},
+				Resolve: r.teamStats,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// field строит резолвер поля, которое берётся из исходного объекта типа T.
+func field[T any](get func(T) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		return get(p.Source.(T)), nil
+	}
+}
+
+// thunk превращает отложенную загрузку в значение поля: null, если объект не найден.
+func thunk[V any](load func() (V, bool, error)) func() (any, error) {
+	return func() (any, error) {
+		v, found, err := load()
+		if err != nil || !found {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func (r *resolvers) team(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).teams.load(p.Context, p.Args["name"].(string))), nil
+}
+
+func (r *resolvers) teams(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	return r.dashboard.ListTeams(p.Context, limit)
+}
+
+func (r *resolvers) user(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).users.load(p.Context, p.Args["id"].(string))), nil
+}
+
+func (r *resolvers) pullRequest(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).pullRequests.load(p.Context, p.Args["id"].(string))), nil
+}
+
+func (r *resolvers) pullRequests(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	team, _ := p.Args["team"].(string)
+	author, _ := p.Args["author"].(string)
+	status, _ := p.Args["status"].(string)
+
+	return r.dashboard.ListPullRequests(p.Context, domain.PullRequestFilter{
+		TeamName: team,
+		AuthorID: author,
+		Status:   status,
+		Limit:    limit,
+	})
+}
+
+func (r *resolvers) reviewerStats(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := r.stats.GetReviewerStats(p.Context, statsFilterFrom(p.Args))
+	if err != nil {
+		return nil, err
+	}
+	return stats[:min(limit, len(stats))], nil
+}
+
+func (r *resolvers) teamStats(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := r.stats.GetTeamStats(p.Context, statsFilterFrom(p.Args))
+	if err != nil {
+		return nil, err
+	}
+	return stats[:min(limit, len(stats))], nil
+}
+
+func (r *resolvers) userTeam(p graphql.ResolveParams) (any, error) {
+	u := p.Source.(domain.User)
+	if u.TeamName == "" {
+		return nil, nil
+	}
+	return thunk(loadersFrom(p.Context).teams.load(p.Context, u.TeamName)), nil
+}
+
+func (r *resolvers) teamMembers(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	members := p.Source.(domain.Team).Members
+	return members[:min(limit, len(members))], nil
+}
+
+// userReviews отдаёт status и limit в запрос к БД, чтобы не читать все
+// назначения пользователя ради первых limit.
+func (r *resolvers) userReviews(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	status, _ := p.Args["status"].(string)
+	load := loadersFrom(p.Context).reviews.load(p.Context, reviewsKey{
+		userID: p.Source.(domain.User).ID,
+		status: status,
+		limit:  limit,
+	})
+
+	return func() (any, error) {
+		prs, _, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if prs == nil {
+			prs = []domain.PullRequest{}
+		}
+		return prs, nil
+	}, nil
+}
+
+func (r *resolvers) pullRequestAuthor(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).users.load(p.Context, p.Source.(domain.PullRequest).AuthorID)), nil
+}
+
+func (r *resolvers) pullRequestReviewers(p graphql.ResolveParams) (any, error) {
+	limit, err := limitFrom(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	ids := p.Source.(domain.PullRequest).ReviewersID
+	load := loadersFrom(p.Context).users.loadMany(p.Context, ids[:min(limit, len(ids))])
+	return func() (any, error) {
+		return load()
+	}, nil
+}
+
+func (r *resolvers) reviewerStatsUser(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).users.load(p.Context, p.Source.(domain.ReviewerStats).UserID)), nil
+}
+
+func (r *resolvers) teamStatsTeam(p graphql.ResolveParams) (any, error) {
+	return thunk(loadersFrom(p.Context).teams.load(p.Context, p.Source.(domain.TeamStats).TeamName)), nil
+}
+
+// limitFrom читает аргумент limit; пределы те же, что у DashboardService.
+func limitFrom(args map[string]any) (int, error) {
+	limit, ok := args["limit"].(int)
+	if !ok {
+		return defaultListLimit, nil
+	}
+	if limit < 1 || limit > service.MaxDashboardLimit {
+		return 0, validation.Invalid("limit", validation.RuleRange, "limit must be in 1..100")
+	}
+	return limit, nil
+}
+
+func statsFilterFrom(args map[string]any) domain.StatsFilter {
+	var filter domain.StatsFilter
+
+	in, _ := args["filter"].(map[string]any)
+	if t, ok := in["from"].(time.Time); ok {
+		filter.From = &t
+	}
+	if t, ok := in["to"].(time.Time); ok {
+		filter.To = &t
+	}
+	filter.TeamName, _ = in["team"].(string)
+	filter.Status, _ = in["status"].(string)
+
+	return filter
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain — домен ErrorInfo, в Reason которого передаётся apperror.Code.
+const errorDomain = "pr-reviewer-service"
+
+// statusCode сопоставляет код ошибки приложения коду gRPC. Соответствие
+// повторяет HTTP-статусы из response.WriteError.
+func statusCode(code apperror.Code) codes.Code {
+	switch code {
+	case apperror.CodeValidation:
+		return codes.InvalidArgument
+	case apperror.CodeUnauthorized:
+		return codes.Unauthenticated
+	case apperror.CodeForbidden:
+		return codes.PermissionDenied
+	case apperror.CodeNotFound:
+		return codes.NotFound
+	case apperror.CodePayloadTooLarge:
+		return codes.ResourceExhausted
+	case apperror.CodeTeamExists, apperror.CodePRExists:
+		return codes.AlreadyExists
+	case apperror.CodePRMerged, apperror.CodeNotAssigned, apperror.CodeNoCandidate, apperror.CodeIdempotencyKeyReused:
+		return codes.FailedPrecondition
+	case apperror.CodeConflict, apperror.CodeConcurrentUpdate, apperror.CodeIdempotencyInProgress:
+		// Клиенту нужно перечитать ресурс и повторить весь цикл чтение-изменение.
+		return codes.Aborted
+	default:
+		return codes.Internal
+	}
+}
+
+// toStatus превращает ошибку сервиса в статус gRPC. Код приложения передаётся
+// в ErrorInfo.Reason, ошибки полей — в BadRequest.FieldViolations. Внутренние
+// ошибки скрываются за «internal error», как и в HTTP.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	appErr := apperror.From(err)
+	if appErr == nil || statusCode(appErr.Code) == codes.Internal {
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	st := status.New(statusCode(appErr.Code), appErr.Message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(appErr.Code), Domain: errorDomain}}
+	if len(appErr.Details) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, d := range appErr.Details {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       d.Field,
+				Description: d.Message,
+				Reason:      d.Rule,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
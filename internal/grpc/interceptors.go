@@ -0,0 +1,282 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/requestid"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/tracing"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDKey — ключ метаданных с идентификатором запроса (аналог X-Request-ID).
+var requestIDKey = strings.ToLower(requestid.Header)
+
+// publicMethodPrefixes — служебные сервисы, доступные без токена, как /healthz в HTTP.
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+func isPublic(fullMethod string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeOf — код, с которым вызов завершится для клиента.
+func codeOf(err error) codes.Code {
+	return status.Code(toStatus(err))
+}
+
+// isServerError отделяет сбои сервера от ошибок клиента — как 5xx в HTTP.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestIDInterceptor берёт x-request-id из метаданных или генерирует новый и
+// возвращает его клиенту в заголовках ответа.
+func requestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if !requestid.Valid(id) {
+		id = requestid.New()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+	return handler(requestid.WithID(ctx, id), req)
+}
+
+// statusInterceptor превращает ошибки сервисов в статусы gRPC. Стоит снаружи
+// трассировки и access-лога, чтобы те видели исходную ошибку целиком.
+func statusInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatus(err)
+}
+
+// tracingInterceptor продолжает трассу из метаданных traceparent и создаёт серверный спан на вызов.
+func tracingInterceptor(tp trace.TracerProvider) grpc.UnaryServerInterceptor {
+	tracer := tp.Tracer(tracing.InstrumentationName + "/grpc")
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = tracing.Propagator.Extract(ctx, metadataCarrier(md))
+
+		service, method := splitMethod(info.FullMethod)
+		ctx, span := tracer.Start(ctx, strings.TrimPrefix(info.FullMethod, "/"),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.RPCSystemGRPC,
+				semconv.RPCService(service),
+				semconv.RPCMethod(method),
+			),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+
+		code := codeOf(err)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(code)))
+		if err != nil {
+			span.RecordError(err)
+		}
+		if isServerError(code) {
+			span.SetStatus(otelcodes.Error, code.String())
+		}
+		return resp, err
+	}
+}
+
+// accessLogInterceptor пишет по одной записи на вызов, как AccessLog для HTTP.
+func accessLogInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := codeOf(err)
+		level := slog.LevelInfo
+		if isServerError(code) {
+			level = slog.LevelError
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("latency", time.Since(start)),
+		}
+		if p, ok := peer.FromContext(ctx); ok {
+			attrs = append(attrs, slog.String("remote_addr", p.Addr.String()))
+		}
+		if err != nil {
+			// Для клиента внутренняя ошибка скрыта за «internal error», в логе — полный текст.
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.LogAttrs(ctx, level, "grpc request", attrs...)
+		return resp, err
+	}
+}
+
+// recoverInterceptor перехватывает панику в обработчике и завершает вызов с кодом INTERNAL.
+func recoverInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+
+			logger.ErrorContext(ctx, "panic in grpc handler",
+				slog.String("method", info.FullMethod),
+				slog.Any("panic", p),
+				slog.String("stack", string(debug.Stack())),
+			)
+			err = apperror.Wrap(apperror.CodeInternal, "internal error", fmt.Errorf("panic: %v", p))
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// authInterceptor требует метаданные authorization: Bearer <token> для всех
+// методов, кроме служебных, и кладёт идентичность вызывающего в контекст.
+func authInterceptor(authenticator auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamInterceptor — то же для потоковых методов.
+func authStreamInterceptor(authenticator auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublic(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), authenticator)
+		if err != nil {
+			return toStatus(err)
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// assumeIdentityInterceptor выполняет вызов от имени id без проверки токена.
+func assumeIdentityInterceptor(id *auth.Identity) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return handler(auth.WithIdentity(ctx, id), req)
+	}
+}
+
+// assumeIdentityStreamInterceptor — то же для потоковых методов.
+func assumeIdentityStreamInterceptor(id *auth.Identity) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublic(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: auth.WithIdentity(ss.Context(), id)})
+	}
+}
+
+func authenticate(ctx context.Context, authenticator auth.Authenticator) (context.Context, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return ctx, apperror.New(apperror.CodeUnauthorized, "bearer token required")
+	}
+
+	identity, err := authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return ctx, err
+	}
+
+	return auth.WithIdentity(ctx, identity), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// splitMethod разбирает "/pkg.Service/Method" на сервис и метод.
+func splitMethod(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return service, method
+}
+
+// metadataCarrier позволяет пропагатору OpenTelemetry читать метаданные gRPC.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
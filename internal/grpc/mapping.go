@@ -0,0 +1,209 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userToPB(u *domain.User) *pb.User {
+	return &pb.User{
+		UserId:   u.ID,
+		Username: u.Name,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func teamToPB(t *domain.Team) *pb.Team {
+	team := &pb.Team{
+		TeamName: t.Name,
+		Members:  make([]*pb.TeamMember, 0, len(t.Members)),
+	}
+	for _, m := range t.Members {
+		team.Members = append(team.Members, &pb.TeamMember{
+			UserId:   m.ID,
+			Username: m.Name,
+			IsActive: m.IsActive,
+		})
+	}
+	return team
+}
+
+func teamFromPB(t *pb.Team) *domain.Team {
+	if t == nil {
+		return nil
+	}
+	team := &domain.Team{
+		Name:    t.GetTeamName(),
+		Members: make([]domain.User, 0, len(t.GetMembers())),
+	}
+	for _, m := range t.GetMembers() {
+		team.Members = append(team.Members, domain.User{
+			ID:       m.GetUserId(),
+			Name:     m.GetUsername(),
+			TeamName: t.GetTeamName(),
+			IsActive: m.GetIsActive(),
+		})
+	}
+	return team
+}
+
+func statusToPB(s string) pb.PullRequestStatus {
+	switch domain.PRStatus(s) {
+	case domain.PRStatusOpen:
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return pb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func statusFromPB(s pb.PullRequestStatus) string {
+	switch s {
+	case pb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return string(domain.PRStatusOpen)
+	case pb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return string(domain.PRStatusMerged)
+	default:
+		return ""
+	}
+}
+
+func pullRequestToPB(pr *domain.PullRequest) *pb.PullRequest {
+	reviewers := pr.ReviewersID
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+	return &pb.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            statusToPB(pr.PullRequestStatus),
+		AssignedReviewers: reviewers,
+		CreatedAt:         timestampToPB(&pr.CreatedAt),
+		MergedAt:          timestampToPB(pr.MergedAt),
+		Version:           pr.Version,
+	}
+}
+
+func pullRequestShortToPB(pr *domain.PullRequest) *pb.PullRequestShort {
+	return &pb.PullRequestShort{
+		PullRequestId:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorID,
+		Status:          statusToPB(pr.PullRequestStatus),
+	}
+}
+
+func timestampToPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timestampFromPB(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func statsFilterFromPB(f *pb.StatsFilter) domain.StatsFilter {
+	return domain.StatsFilter{
+		From:     timestampFromPB(f.GetFrom()),
+		To:       timestampFromPB(f.GetTo()),
+		TeamName: f.GetTeamName(),
+		Status:   statusFromPB(f.GetStatus()),
+	}
+}
+
+func durationStatsToPB(s domain.DurationStats) *pb.DurationStats {
+	return &pb.DurationStats{
+		Count:      s.Count,
+		P50Seconds: s.P50,
+		P90Seconds: s.P90,
+		P99Seconds: s.P99,
+	}
+}
+
+func latencyToPB(r *domain.LatencyReport) *pb.GetLatencyResponse {
+	resp := &pb.GetLatencyResponse{
+		TimeToMergeByTeam:      make([]*pb.TeamLatency, 0, len(r.TimeToMergeByTeam)),
+		TimeToMergeByAuthor:    make([]*pb.AuthorLatency, 0, len(r.TimeToMergeByAuthor)),
+		TimeToFirstReview:      make([]*pb.ReviewerLatency, 0, len(r.TimeToFirstReview)),
+		TimeToMergeWeeklyTrend: make([]*pb.LatencyTrendBucket, 0, len(r.TimeToMergeWeeklyTrend)),
+	}
+	for _, t := range r.TimeToMergeByTeam {
+		resp.TimeToMergeByTeam = append(resp.TimeToMergeByTeam, &pb.TeamLatency{
+			TeamName: t.TeamName,
+			Stats:    durationStatsToPB(t.DurationStats),
+		})
+	}
+	for _, a := range r.TimeToMergeByAuthor {
+		resp.TimeToMergeByAuthor = append(resp.TimeToMergeByAuthor, &pb.AuthorLatency{
+			UserId:   a.UserID,
+			Username: a.UserName,
+			TeamName: a.TeamName,
+			Stats:    durationStatsToPB(a.DurationStats),
+		})
+	}
+	for _, rv := range r.TimeToFirstReview {
+		resp.TimeToFirstReview = append(resp.TimeToFirstReview, &pb.ReviewerLatency{
+			UserId:   rv.UserID,
+			Username: rv.UserName,
+			Stats:    durationStatsToPB(rv.DurationStats),
+		})
+	}
+	for _, b := range r.TimeToMergeWeeklyTrend {
+		resp.TimeToMergeWeeklyTrend = append(resp.TimeToMergeWeeklyTrend, &pb.LatencyTrendBucket{
+			WeekStart: timestampToPB(&b.WeekStart),
+			Stats:     durationStatsToPB(b.DurationStats),
+		})
+	}
+	return resp
+}
+
+func outlierToPB(o domain.LoadOutlier) pb.LoadOutlier {
+	switch o {
+	case domain.LoadOverloaded:
+		return pb.LoadOutlier_LOAD_OUTLIER_OVERLOADED
+	case domain.LoadUnderloaded:
+		return pb.LoadOutlier_LOAD_OUTLIER_UNDERLOADED
+	default:
+		return pb.LoadOutlier_LOAD_OUTLIER_UNSPECIFIED
+	}
+}
+
+func fairnessToPB(teams []domain.TeamFairness) *pb.GetFairnessResponse {
+	resp := &pb.GetFairnessResponse{Teams: make([]*pb.TeamFairness, 0, len(teams))}
+	for _, t := range teams {
+		team := &pb.TeamFairness{
+			TeamName:      t.TeamName,
+			From:          timestampToPB(&t.From),
+			To:            timestampToPB(&t.To),
+			TotalAssigned: t.TotalAssigned,
+			Gini:          t.Gini,
+			Members:       make([]*pb.MemberFairness, 0, len(t.Members)),
+		}
+		for _, m := range t.Members {
+			team.Members = append(team.Members, &pb.MemberFairness{
+				UserId:        m.UserID,
+				Username:      m.UserName,
+				AssignedCount: m.AssignedCount,
+				ActiveRatio:   m.ActiveRatio,
+				Share:         m.Share,
+				ExpectedShare: m.ExpectedShare,
+				LoadRatio:     m.LoadRatio,
+				Outlier:       outlierToPB(m.Outlier),
+			})
+		}
+		resp.Teams = append(resp.Teams, team)
+	}
+	return resp
+}
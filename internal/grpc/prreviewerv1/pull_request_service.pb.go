@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: prreviewer/v1/pull_request_service.proto
+
+package prreviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type GetPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MergePullRequestRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MergePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId       string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReassignReviewerResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type MarkReviewedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId      string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkReviewedRequest) Reset() {
+	*x = MarkReviewedRequest{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReviewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReviewedRequest) ProtoMessage() {}
+
+func (x *MarkReviewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReviewedRequest.ProtoReflect.Descriptor instead.
+func (*MarkReviewedRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MarkReviewedRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MarkReviewedRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *MarkReviewedRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MarkReviewedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReviewedResponse) Reset() {
+	*x = MarkReviewedResponse{}
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReviewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReviewedResponse) ProtoMessage() {}
+
+func (x *MarkReviewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_pull_request_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReviewedResponse.ProtoReflect.Descriptor instead.
+func (*MarkReviewedResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_pull_request_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkReviewedResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+var File_prreviewer_v1_pull_request_service_proto protoreflect.FileDescriptor
+
+const file_prreviewer_v1_pull_request_service_proto_rawDesc = "" +
+	"\n" +
+	"(prreviewer/v1/pull_request_service.proto\x12\rprreviewer.v1\x1a\x1aprreviewer/v1/common.proto\"\x8b\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"Z\n" +
+	"\x19CreatePullRequestResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"W\n" +
+	"\x16GetPullRequestResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\"l\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"Y\n" +
+	"\x18MergePullRequestResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\"\x8c\x01\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"z\n" +
+	"\x18ReassignReviewerResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\x89\x01\n" +
+	"\x13MarkReviewedRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"U\n" +
+	"\x14MarkReviewedResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest2\xfe\x03\n" +
+	"\x12PullRequestService\x12f\n" +
+	"\x11CreatePullRequest\x12'.prreviewer.v1.CreatePullRequestRequest\x1a(.prreviewer.v1.CreatePullRequestResponse\x12]\n" +
+	"\x0eGetPullRequest\x12$.prreviewer.v1.GetPullRequestRequest\x1a%.prreviewer.v1.GetPullRequestResponse\x12c\n" +
+	"\x10MergePullRequest\x12&.prreviewer.v1.MergePullRequestRequest\x1a'.prreviewer.v1.MergePullRequestResponse\x12c\n" +
+	"\x10ReassignReviewer\x12&.prreviewer.v1.ReassignReviewerRequest\x1a'.prreviewer.v1.ReassignReviewerResponse\x12W\n" +
+	"\fMarkReviewed\x12\".prreviewer.v1.MarkReviewedRequest\x1a#.prreviewer.v1.MarkReviewedResponseBVZTgithub.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1;prreviewerv1b\x06proto3"
+
+var (
+	file_prreviewer_v1_pull_request_service_proto_rawDescOnce sync.Once
+	file_prreviewer_v1_pull_request_service_proto_rawDescData []byte
+)
+
+func file_prreviewer_v1_pull_request_service_proto_rawDescGZIP() []byte {
+	file_prreviewer_v1_pull_request_service_proto_rawDescOnce.Do(func() {
+		file_prreviewer_v1_pull_request_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prreviewer_v1_pull_request_service_proto_rawDesc), len(file_prreviewer_v1_pull_request_service_proto_rawDesc)))
+	})
+	return file_prreviewer_v1_pull_request_service_proto_rawDescData
+}
+
+var file_prreviewer_v1_pull_request_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_prreviewer_v1_pull_request_service_proto_goTypes = []any{
+	(*CreatePullRequestRequest)(nil),  // 0: prreviewer.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil), // 1: prreviewer.v1.CreatePullRequestResponse
+	(*GetPullRequestRequest)(nil),     // 2: prreviewer.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),    // 3: prreviewer.v1.GetPullRequestResponse
+	(*MergePullRequestRequest)(nil),   // 4: prreviewer.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),  // 5: prreviewer.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),   // 6: prreviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),  // 7: prreviewer.v1.ReassignReviewerResponse
+	(*MarkReviewedRequest)(nil),       // 8: prreviewer.v1.MarkReviewedRequest
+	(*MarkReviewedResponse)(nil),      // 9: prreviewer.v1.MarkReviewedResponse
+	(*PullRequest)(nil),               // 10: prreviewer.v1.PullRequest
+}
+var file_prreviewer_v1_pull_request_service_proto_depIdxs = []int32{
+	10, // 0: prreviewer.v1.CreatePullRequestResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	10, // 1: prreviewer.v1.GetPullRequestResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	10, // 2: prreviewer.v1.MergePullRequestResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	10, // 3: prreviewer.v1.ReassignReviewerResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	10, // 4: prreviewer.v1.MarkReviewedResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	0,  // 5: prreviewer.v1.PullRequestService.CreatePullRequest:input_type -> prreviewer.v1.CreatePullRequestRequest
+	2,  // 6: prreviewer.v1.PullRequestService.GetPullRequest:input_type -> prreviewer.v1.GetPullRequestRequest
+	4,  // 7: prreviewer.v1.PullRequestService.MergePullRequest:input_type -> prreviewer.v1.MergePullRequestRequest
+	6,  // 8: prreviewer.v1.PullRequestService.ReassignReviewer:input_type -> prreviewer.v1.ReassignReviewerRequest
+	8,  // 9: prreviewer.v1.PullRequestService.MarkReviewed:input_type -> prreviewer.v1.MarkReviewedRequest
+	1,  // 10: prreviewer.v1.PullRequestService.CreatePullRequest:output_type -> prreviewer.v1.CreatePullRequestResponse
+	3,  // 11: prreviewer.v1.PullRequestService.GetPullRequest:output_type -> prreviewer.v1.GetPullRequestResponse
+	5,  // 12: prreviewer.v1.PullRequestService.MergePullRequest:output_type -> prreviewer.v1.MergePullRequestResponse
+	7,  // 13: prreviewer.v1.PullRequestService.ReassignReviewer:output_type -> prreviewer.v1.ReassignReviewerResponse
+	9,  // 14: prreviewer.v1.PullRequestService.MarkReviewed:output_type -> prreviewer.v1.MarkReviewedResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_prreviewer_v1_pull_request_service_proto_init() }
+func file_prreviewer_v1_pull_request_service_proto_init() {
+	if File_prreviewer_v1_pull_request_service_proto != nil {
+		return
+	}
+	file_prreviewer_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prreviewer_v1_pull_request_service_proto_rawDesc), len(file_prreviewer_v1_pull_request_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prreviewer_v1_pull_request_service_proto_goTypes,
+		DependencyIndexes: file_prreviewer_v1_pull_request_service_proto_depIdxs,
+		MessageInfos:      file_prreviewer_v1_pull_request_service_proto_msgTypes,
+	}.Build()
+	File_prreviewer_v1_pull_request_service_proto = out.File
+	file_prreviewer_v1_pull_request_service_proto_goTypes = nil
+	file_prreviewer_v1_pull_request_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: prreviewer/v1/stats_service.proto
+
+package prreviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoadOutlier int32
+
+const (
+	LoadOutlier_LOAD_OUTLIER_UNSPECIFIED LoadOutlier = 0
+	LoadOutlier_LOAD_OUTLIER_OVERLOADED  LoadOutlier = 1
+	LoadOutlier_LOAD_OUTLIER_UNDERLOADED LoadOutlier = 2
+)
+
+// Enum value maps for LoadOutlier.
+var (
+	LoadOutlier_name = map[int32]string{
+		0: "LOAD_OUTLIER_UNSPECIFIED",
+		1: "LOAD_OUTLIER_OVERLOADED",
+		2: "LOAD_OUTLIER_UNDERLOADED",
+	}
+	LoadOutlier_value = map[string]int32{
+		"LOAD_OUTLIER_UNSPECIFIED": 0,
+		"LOAD_OUTLIER_OVERLOADED":  1,
+		"LOAD_OUTLIER_UNDERLOADED": 2,
+	}
+)
+
+func (x LoadOutlier) Enum() *LoadOutlier {
+	p := new(LoadOutlier)
+	*p = x
+	return p
+}
+
+func (x LoadOutlier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoadOutlier) Descriptor() protoreflect.EnumDescriptor {
+	return file_prreviewer_v1_stats_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LoadOutlier) Type() protoreflect.EnumType {
+	return &file_prreviewer_v1_stats_service_proto_enumTypes[0]
+}
+
+func (x LoadOutlier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoadOutlier.Descriptor instead.
+func (LoadOutlier) EnumDescriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{0}
+}
+
+// StatsFilter ограничивает выборку. Незаданные поля не фильтруют.
+type StatsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Начало окна по created_at PR, включительно.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Конец окна, не включительно.
+	To       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeamName string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Поддерживается только в GetReviewerStats и GetTeamStats.
+	Status        PullRequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsFilter) Reset() {
+	*x = StatsFilter{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsFilter) ProtoMessage() {}
+
+func (x *StatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsFilter.ProtoReflect.Descriptor instead.
+func (*StatsFilter) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatsFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type ReviewerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AssignedCount int64                  `protobuf:"varint,3,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	OpenCount     int64                  `protobuf:"varint,4,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`
+	MergedCount   int64                  `protobuf:"varint,5,opt,name=merged_count,json=mergedCount,proto3" json:"merged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerStats) Reset() {
+	*x = ReviewerStats{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerStats) ProtoMessage() {}
+
+func (x *ReviewerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerStats.ProtoReflect.Descriptor instead.
+func (*ReviewerStats) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewerStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetAssignedCount() int64 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
+func (x *ReviewerStats) GetOpenCount() int64 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *ReviewerStats) GetMergedCount() int64 {
+	if x != nil {
+		return x.MergedCount
+	}
+	return 0
+}
+
+type GetReviewerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsRequest) Reset() {
+	*x = GetReviewerStatsRequest{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsRequest) ProtoMessage() {}
+
+func (x *GetReviewerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReviewerStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetReviewerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReviewerStats       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsResponse) Reset() {
+	*x = GetReviewerStatsResponse{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsResponse) ProtoMessage() {}
+
+func (x *GetReviewerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReviewerStatsResponse) GetItems() []*ReviewerStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TeamStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	AssignedCount int64                  `protobuf:"varint,2,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	MergedCount   int64                  `protobuf:"varint,3,opt,name=merged_count,json=mergedCount,proto3" json:"merged_count,omitempty"`
+	MembersCount  int64                  `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	ActiveMembers int64                  `protobuf:"varint,5,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamStats) Reset() {
+	*x = TeamStats{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStats) ProtoMessage() {}
+
+func (x *TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStats.ProtoReflect.Descriptor instead.
+func (*TeamStats) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TeamStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamStats) GetAssignedCount() int64 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetMergedCount() int64 {
+	if x != nil {
+		return x.MergedCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetMembersCount() int64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetActiveMembers() int64 {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return 0
+}
+
+type GetTeamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsRequest) Reset() {
+	*x = GetTeamStatsRequest{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTeamStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetTeamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TeamStats           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsResponse) Reset() {
+	*x = GetTeamStatsResponse{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamStatsResponse) GetItems() []*TeamStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// DurationStats — перцентили длительностей в секундах.
+type DurationStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	P50Seconds    float64                `protobuf:"fixed64,2,opt,name=p50_seconds,json=p50Seconds,proto3" json:"p50_seconds,omitempty"`
+	P90Seconds    float64                `protobuf:"fixed64,3,opt,name=p90_seconds,json=p90Seconds,proto3" json:"p90_seconds,omitempty"`
+	P99Seconds    float64                `protobuf:"fixed64,4,opt,name=p99_seconds,json=p99Seconds,proto3" json:"p99_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurationStats) Reset() {
+	*x = DurationStats{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationStats) ProtoMessage() {}
+
+func (x *DurationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DurationStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationStats) GetP50Seconds() float64 {
+	if x != nil {
+		return x.P50Seconds
+	}
+	return 0
+}
+
+func (x *DurationStats) GetP90Seconds() float64 {
+	if x != nil {
+		return x.P90Seconds
+	}
+	return 0
+}
+
+func (x *DurationStats) GetP99Seconds() float64 {
+	if x != nil {
+		return x.P99Seconds
+	}
+	return 0
+}
+
+type TeamLatency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Stats         *DurationStats         `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamLatency) Reset() {
+	*x = TeamLatency{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamLatency) ProtoMessage() {}
+
+func (x *TeamLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamLatency.ProtoReflect.Descriptor instead.
+func (*TeamLatency) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeamLatency) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamLatency) GetStats() *DurationStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type AuthorLatency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Stats         *DurationStats         `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorLatency) Reset() {
+	*x = AuthorLatency{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorLatency) ProtoMessage() {}
+
+func (x *AuthorLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorLatency.ProtoReflect.Descriptor instead.
+func (*AuthorLatency) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthorLatency) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorLatency) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthorLatency) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AuthorLatency) GetStats() *DurationStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ReviewerLatency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Stats         *DurationStats         `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerLatency) Reset() {
+	*x = ReviewerLatency{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerLatency) ProtoMessage() {}
+
+func (x *ReviewerLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerLatency.ProtoReflect.Descriptor instead.
+func (*ReviewerLatency) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReviewerLatency) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerLatency) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerLatency) GetStats() *DurationStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type LatencyTrendBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	Stats         *DurationStats         `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencyTrendBucket) Reset() {
+	*x = LatencyTrendBucket{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencyTrendBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyTrendBucket) ProtoMessage() {}
+
+func (x *LatencyTrendBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyTrendBucket.ProtoReflect.Descriptor instead.
+func (*LatencyTrendBucket) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LatencyTrendBucket) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *LatencyTrendBucket) GetStats() *DurationStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetLatencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatencyRequest) Reset() {
+	*x = GetLatencyRequest{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyRequest) ProtoMessage() {}
+
+func (x *GetLatencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyRequest.ProtoReflect.Descriptor instead.
+func (*GetLatencyRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLatencyRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetLatencyResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TimeToMergeByTeam      []*TeamLatency         `protobuf:"bytes,1,rep,name=time_to_merge_by_team,json=timeToMergeByTeam,proto3" json:"time_to_merge_by_team,omitempty"`
+	TimeToMergeByAuthor    []*AuthorLatency       `protobuf:"bytes,2,rep,name=time_to_merge_by_author,json=timeToMergeByAuthor,proto3" json:"time_to_merge_by_author,omitempty"`
+	TimeToFirstReview      []*ReviewerLatency     `protobuf:"bytes,3,rep,name=time_to_first_review,json=timeToFirstReview,proto3" json:"time_to_first_review,omitempty"`
+	TimeToMergeWeeklyTrend []*LatencyTrendBucket  `protobuf:"bytes,4,rep,name=time_to_merge_weekly_trend,json=timeToMergeWeeklyTrend,proto3" json:"time_to_merge_weekly_trend,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetLatencyResponse) Reset() {
+	*x = GetLatencyResponse{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencyResponse) ProtoMessage() {}
+
+func (x *GetLatencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencyResponse.ProtoReflect.Descriptor instead.
+func (*GetLatencyResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetLatencyResponse) GetTimeToMergeByTeam() []*TeamLatency {
+	if x != nil {
+		return x.TimeToMergeByTeam
+	}
+	return nil
+}
+
+func (x *GetLatencyResponse) GetTimeToMergeByAuthor() []*AuthorLatency {
+	if x != nil {
+		return x.TimeToMergeByAuthor
+	}
+	return nil
+}
+
+func (x *GetLatencyResponse) GetTimeToFirstReview() []*ReviewerLatency {
+	if x != nil {
+		return x.TimeToFirstReview
+	}
+	return nil
+}
+
+func (x *GetLatencyResponse) GetTimeToMergeWeeklyTrend() []*LatencyTrendBucket {
+	if x != nil {
+		return x.TimeToMergeWeeklyTrend
+	}
+	return nil
+}
+
+type MemberFairness struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AssignedCount int64                  `protobuf:"varint,3,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	ActiveRatio   float64                `protobuf:"fixed64,4,opt,name=active_ratio,json=activeRatio,proto3" json:"active_ratio,omitempty"`
+	Share         float64                `protobuf:"fixed64,5,opt,name=share,proto3" json:"share,omitempty"`
+	ExpectedShare float64                `protobuf:"fixed64,6,opt,name=expected_share,json=expectedShare,proto3" json:"expected_share,omitempty"`
+	LoadRatio     float64                `protobuf:"fixed64,7,opt,name=load_ratio,json=loadRatio,proto3" json:"load_ratio,omitempty"`
+	// UNSPECIFIED — нагрузка в пределах нормы.
+	Outlier       LoadOutlier `protobuf:"varint,8,opt,name=outlier,proto3,enum=prreviewer.v1.LoadOutlier" json:"outlier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberFairness) Reset() {
+	*x = MemberFairness{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberFairness) ProtoMessage() {}
+
+func (x *MemberFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberFairness.ProtoReflect.Descriptor instead.
+func (*MemberFairness) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MemberFairness) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberFairness) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MemberFairness) GetAssignedCount() int64 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetActiveRatio() float64 {
+	if x != nil {
+		return x.ActiveRatio
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetExpectedShare() float64 {
+	if x != nil {
+		return x.ExpectedShare
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetLoadRatio() float64 {
+	if x != nil {
+		return x.LoadRatio
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetOutlier() LoadOutlier {
+	if x != nil {
+		return x.Outlier
+	}
+	return LoadOutlier_LOAD_OUTLIER_UNSPECIFIED
+}
+
+type TeamFairness struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TotalAssigned int64                  `protobuf:"varint,4,opt,name=total_assigned,json=totalAssigned,proto3" json:"total_assigned,omitempty"`
+	Gini          float64                `protobuf:"fixed64,5,opt,name=gini,proto3" json:"gini,omitempty"`
+	Members       []*MemberFairness      `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamFairness) Reset() {
+	*x = TeamFairness{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFairness) ProtoMessage() {}
+
+func (x *TeamFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFairness.ProtoReflect.Descriptor instead.
+func (*TeamFairness) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamFairness) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFairness) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TeamFairness) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TeamFairness) GetTotalAssigned() int64 {
+	if x != nil {
+		return x.TotalAssigned
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMembers() []*MemberFairness {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetFairnessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessRequest) Reset() {
+	*x = GetFairnessRequest{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessRequest) ProtoMessage() {}
+
+func (x *GetFairnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessRequest.ProtoReflect.Descriptor instead.
+func (*GetFairnessRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFairnessRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetFairnessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamFairness        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessResponse) Reset() {
+	*x = GetFairnessResponse{}
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessResponse) ProtoMessage() {}
+
+func (x *GetFairnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_stats_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessResponse.ProtoReflect.Descriptor instead.
+func (*GetFairnessResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_stats_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFairnessResponse) GetTeams() []*TeamFairness {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+var File_prreviewer_v1_stats_service_proto protoreflect.FileDescriptor
+
+const file_prreviewer_v1_stats_service_proto_rawDesc = "" +
+	"\n" +
+	"!prreviewer/v1/stats_service.proto\x12\rprreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1aprreviewer/v1/common.proto\"\xc0\x01\n" +
+	"\vStatsFilter\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\"\xad\x01\n" +
+	"\rReviewerStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12%\n" +
+	"\x0eassigned_count\x18\x03 \x01(\x03R\rassignedCount\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x04 \x01(\x03R\topenCount\x12!\n" +
+	"\fmerged_count\x18\x05 \x01(\x03R\vmergedCount\"M\n" +
+	"\x17GetReviewerStatsRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.prreviewer.v1.StatsFilterR\x06filter\"N\n" +
+	"\x18GetReviewerStatsResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.prreviewer.v1.ReviewerStatsR\x05items\"\xbe\x01\n" +
+	"\tTeamStats\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12%\n" +
+	"\x0eassigned_count\x18\x02 \x01(\x03R\rassignedCount\x12!\n" +
+	"\fmerged_count\x18\x03 \x01(\x03R\vmergedCount\x12#\n" +
+	"\rmembers_count\x18\x04 \x01(\x03R\fmembersCount\x12%\n" +
+	"\x0eactive_members\x18\x05 \x01(\x03R\ractiveMembers\"I\n" +
+	"\x13GetTeamStatsRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.prreviewer.v1.StatsFilterR\x06filter\"F\n" +
+	"\x14GetTeamStatsResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.prreviewer.v1.TeamStatsR\x05items\"\x88\x01\n" +
+	"\rDurationStats\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vp50_seconds\x18\x02 \x01(\x01R\n" +
+	"p50Seconds\x12\x1f\n" +
+	"\vp90_seconds\x18\x03 \x01(\x01R\n" +
+	"p90Seconds\x12\x1f\n" +
+	"\vp99_seconds\x18\x04 \x01(\x01R\n" +
+	"p99Seconds\"^\n" +
+	"\vTeamLatency\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x122\n" +
+	"\x05stats\x18\x02 \x01(\v2\x1c.prreviewer.v1.DurationStatsR\x05stats\"\x95\x01\n" +
+	"\rAuthorLatency\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x122\n" +
+	"\x05stats\x18\x04 \x01(\v2\x1c.prreviewer.v1.DurationStatsR\x05stats\"z\n" +
+	"\x0fReviewerLatency\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x122\n" +
+	"\x05stats\x18\x03 \x01(\v2\x1c.prreviewer.v1.DurationStatsR\x05stats\"\x83\x01\n" +
+	"\x12LatencyTrendBucket\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x122\n" +
+	"\x05stats\x18\x02 \x01(\v2\x1c.prreviewer.v1.DurationStatsR\x05stats\"G\n" +
+	"\x11GetLatencyRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.prreviewer.v1.StatsFilterR\x06filter\"\xe6\x02\n" +
+	"\x12GetLatencyResponse\x12L\n" +
+	"\x15time_to_merge_by_team\x18\x01 \x03(\v2\x1a.prreviewer.v1.TeamLatencyR\x11timeToMergeByTeam\x12R\n" +
+	"\x17time_to_merge_by_author\x18\x02 \x03(\v2\x1c.prreviewer.v1.AuthorLatencyR\x13timeToMergeByAuthor\x12O\n" +
+	"\x14time_to_first_review\x18\x03 \x03(\v2\x1e.prreviewer.v1.ReviewerLatencyR\x11timeToFirstReview\x12]\n" +
+	"\x1atime_to_merge_weekly_trend\x18\x04 \x03(\v2!.prreviewer.v1.LatencyTrendBucketR\x16timeToMergeWeeklyTrend\"\xa1\x02\n" +
+	"\x0eMemberFairness\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12%\n" +
+	"\x0eassigned_count\x18\x03 \x01(\x03R\rassignedCount\x12!\n" +
+	"\factive_ratio\x18\x04 \x01(\x01R\vactiveRatio\x12\x14\n" +
+	"\x05share\x18\x05 \x01(\x01R\x05share\x12%\n" +
+	"\x0eexpected_share\x18\x06 \x01(\x01R\rexpectedShare\x12\x1d\n" +
+	"\n" +
+	"load_ratio\x18\a \x01(\x01R\tloadRatio\x124\n" +
+	"\aoutlier\x18\b \x01(\x0e2\x1a.prreviewer.v1.LoadOutlierR\aoutlier\"\xfb\x01\n" +
+	"\fTeamFairness\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12%\n" +
+	"\x0etotal_assigned\x18\x04 \x01(\x03R\rtotalAssigned\x12\x12\n" +
+	"\x04gini\x18\x05 \x01(\x01R\x04gini\x127\n" +
+	"\amembers\x18\x06 \x03(\v2\x1d.prreviewer.v1.MemberFairnessR\amembers\"H\n" +
+	"\x12GetFairnessRequest\x122\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1a.prreviewer.v1.StatsFilterR\x06filter\"H\n" +
+	"\x13GetFairnessResponse\x121\n" +
+	"\x05teams\x18\x01 \x03(\v2\x1b.prreviewer.v1.TeamFairnessR\x05teams*f\n" +
+	"\vLoadOutlier\x12\x1c\n" +
+	"\x18LOAD_OUTLIER_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17LOAD_OUTLIER_OVERLOADED\x10\x01\x12\x1c\n" +
+	"\x18LOAD_OUTLIER_UNDERLOADED\x10\x022\xf5\x02\n" +
+	"\fStatsService\x12c\n" +
+	"\x10GetReviewerStats\x12&.prreviewer.v1.GetReviewerStatsRequest\x1a'.prreviewer.v1.GetReviewerStatsResponse\x12W\n" +
+	"\fGetTeamStats\x12\".prreviewer.v1.GetTeamStatsRequest\x1a#.prreviewer.v1.GetTeamStatsResponse\x12Q\n" +
+	"\n" +
+	"GetLatency\x12 .prreviewer.v1.GetLatencyRequest\x1a!.prreviewer.v1.GetLatencyResponse\x12T\n" +
+	"\vGetFairness\x12!.prreviewer.v1.GetFairnessRequest\x1a\".prreviewer.v1.GetFairnessResponseBVZTgithub.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1;prreviewerv1b\x06proto3"
+
+var (
+	file_prreviewer_v1_stats_service_proto_rawDescOnce sync.Once
+	file_prreviewer_v1_stats_service_proto_rawDescData []byte
+)
+
+func file_prreviewer_v1_stats_service_proto_rawDescGZIP() []byte {
+	file_prreviewer_v1_stats_service_proto_rawDescOnce.Do(func() {
+		file_prreviewer_v1_stats_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prreviewer_v1_stats_service_proto_rawDesc), len(file_prreviewer_v1_stats_service_proto_rawDesc)))
+	})
+	return file_prreviewer_v1_stats_service_proto_rawDescData
+}
+
+var file_prreviewer_v1_stats_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_prreviewer_v1_stats_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_prreviewer_v1_stats_service_proto_goTypes = []any{
+	(LoadOutlier)(0),                 // 0: prreviewer.v1.LoadOutlier
+	(*StatsFilter)(nil),              // 1: prreviewer.v1.StatsFilter
+	(*ReviewerStats)(nil),            // 2: prreviewer.v1.ReviewerStats
+	(*GetReviewerStatsRequest)(nil),  // 3: prreviewer.v1.GetReviewerStatsRequest
+	(*GetReviewerStatsResponse)(nil), // 4: prreviewer.v1.GetReviewerStatsResponse
+	(*TeamStats)(nil),                // 5: prreviewer.v1.TeamStats
+	(*GetTeamStatsRequest)(nil),      // 6: prreviewer.v1.GetTeamStatsRequest
+	(*GetTeamStatsResponse)(nil),     // 7: prreviewer.v1.GetTeamStatsResponse
+	(*DurationStats)(nil),            // 8: prreviewer.v1.DurationStats
+	(*TeamLatency)(nil),              // 9: prreviewer.v1.TeamLatency
+	(*AuthorLatency)(nil),            // 10: prreviewer.v1.AuthorLatency
+	(*ReviewerLatency)(nil),          // 11: prreviewer.v1.ReviewerLatency
+	(*LatencyTrendBucket)(nil),       // 12: prreviewer.v1.LatencyTrendBucket
+	(*GetLatencyRequest)(nil),        // 13: prreviewer.v1.GetLatencyRequest
+	(*GetLatencyResponse)(nil),       // 14: prreviewer.v1.GetLatencyResponse
+	(*MemberFairness)(nil),           // 15: prreviewer.v1.MemberFairness
+	(*TeamFairness)(nil),             // 16: prreviewer.v1.TeamFairness
+	(*GetFairnessRequest)(nil),       // 17: prreviewer.v1.GetFairnessRequest
+	(*GetFairnessResponse)(nil),      // 18: prreviewer.v1.GetFairnessResponse
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(PullRequestStatus)(0),           // 20: prreviewer.v1.PullRequestStatus
+}
+var file_prreviewer_v1_stats_service_proto_depIdxs = []int32{
+	19, // 0: prreviewer.v1.StatsFilter.from:type_name -> google.protobuf.Timestamp
+	19, // 1: prreviewer.v1.StatsFilter.to:type_name -> google.protobuf.Timestamp
+	20, // 2: prreviewer.v1.StatsFilter.status:type_name -> prreviewer.v1.PullRequestStatus
+	1,  // 3: prreviewer.v1.GetReviewerStatsRequest.filter:type_name -> prreviewer.v1.StatsFilter
+	2,  // 4: prreviewer.v1.GetReviewerStatsResponse.items:type_name -> prreviewer.v1.ReviewerStats
+	1,  // 5: prreviewer.v1.GetTeamStatsRequest.filter:type_name -> prreviewer.v1.StatsFilter
+	5,  // 6: prreviewer.v1.GetTeamStatsResponse.items:type_name -> prreviewer.v1.TeamStats
+	8,  // 7: prreviewer.v1.TeamLatency.stats:type_name -> prreviewer.v1.DurationStats
+	8,  // 8: prreviewer.v1.AuthorLatency.stats:type_name -> prreviewer.v1.DurationStats
+	8,  // 9: prreviewer.v1.ReviewerLatency.stats:type_name -> prreviewer.v1.DurationStats
+	19, // 10: prreviewer.v1.LatencyTrendBucket.week_start:type_name -> google.protobuf.Timestamp
+	8,  // 11: prreviewer.v1.LatencyTrendBucket.stats:type_name -> prreviewer.v1.DurationStats
+	1,  // 12: prreviewer.v1.GetLatencyRequest.filter:type_name -> prreviewer.v1.StatsFilter
+	9,  // 13: prreviewer.v1.GetLatencyResponse.time_to_merge_by_team:type_name -> prreviewer.v1.TeamLatency
+	10, // 14: prreviewer.v1.GetLatencyResponse.time_to_merge_by_author:type_name -> prreviewer.v1.AuthorLatency
+	11, // 15: prreviewer.v1.GetLatencyResponse.time_to_first_review:type_name -> prreviewer.v1.ReviewerLatency
+	12, // 16: prreviewer.v1.GetLatencyResponse.time_to_merge_weekly_trend:type_name -> prreviewer.v1.LatencyTrendBucket
+	0,  // 17: prreviewer.v1.MemberFairness.outlier:type_name -> prreviewer.v1.LoadOutlier
+	19, // 18: prreviewer.v1.TeamFairness.from:type_name -> google.protobuf.Timestamp
+	19, // 19: prreviewer.v1.TeamFairness.to:type_name -> google.protobuf.Timestamp
+	15, // 20: prreviewer.v1.TeamFairness.members:type_name -> prreviewer.v1.MemberFairness
+	1,  // 21: prreviewer.v1.GetFairnessRequest.filter:type_name -> prreviewer.v1.StatsFilter
+	16, // 22: prreviewer.v1.GetFairnessResponse.teams:type_name -> prreviewer.v1.TeamFairness
+	3,  // 23: prreviewer.v1.StatsService.GetReviewerStats:input_type -> prreviewer.v1.GetReviewerStatsRequest
+	6,  // 24: prreviewer.v1.StatsService.GetTeamStats:input_type -> prreviewer.v1.GetTeamStatsRequest
+	13, // 25: prreviewer.v1.StatsService.GetLatency:input_type -> prreviewer.v1.GetLatencyRequest
+	17, // 26: prreviewer.v1.StatsService.GetFairness:input_type -> prreviewer.v1.GetFairnessRequest
+	4,  // 27: prreviewer.v1.StatsService.GetReviewerStats:output_type -> prreviewer.v1.GetReviewerStatsResponse
+	7,  // 28: prreviewer.v1.StatsService.GetTeamStats:output_type -> prreviewer.v1.GetTeamStatsResponse
+	14, // 29: prreviewer.v1.StatsService.GetLatency:output_type -> prreviewer.v1.GetLatencyResponse
+	18, // 30: prreviewer.v1.StatsService.GetFairness:output_type -> prreviewer.v1.GetFairnessResponse
+	27, // [27:31] is the sub-list for method output_type
+	23, // [23:27] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_prreviewer_v1_stats_service_proto_init() }
+func file_prreviewer_v1_stats_service_proto_init() {
+	if File_prreviewer_v1_stats_service_proto != nil {
+		return
+	}
+	file_prreviewer_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prreviewer_v1_stats_service_proto_rawDesc), len(file_prreviewer_v1_stats_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prreviewer_v1_stats_service_proto_goTypes,
+		DependencyIndexes: file_prreviewer_v1_stats_service_proto_depIdxs,
+		EnumInfos:         file_prreviewer_v1_stats_service_proto_enumTypes,
+		MessageInfos:      file_prreviewer_v1_stats_service_proto_msgTypes,
+	}.Build()
+	File_prreviewer_v1_stats_service_proto = out.File
+	file_prreviewer_v1_stats_service_proto_goTypes = nil
+	file_prreviewer_v1_stats_service_proto_depIdxs = nil
+}
@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: prreviewer/v1/team_service.proto
+
+package prreviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_team_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_team_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_team_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prreviewer_v1_team_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prreviewer_v1_team_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+var File_prreviewer_v1_team_service_proto protoreflect.FileDescriptor
+
+const file_prreviewer_v1_team_service_proto_rawDesc = "" +
+	"\n" +
+	" prreviewer/v1/team_service.proto\x12\rprreviewer.v1\x1a\x1aprreviewer/v1/common.proto\"9\n" +
+	"\x0eAddTeamRequest\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.prreviewer.v1.TeamR\x04team\":\n" +
+	"\x0fAddTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.prreviewer.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\":\n" +
+	"\x0fGetTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.prreviewer.v1.TeamR\x04team2\xa1\x01\n" +
+	"\vTeamService\x12H\n" +
+	"\aAddTeam\x12\x1d.prreviewer.v1.AddTeamRequest\x1a\x1e.prreviewer.v1.AddTeamResponse\x12H\n" +
+	"\aGetTeam\x12\x1d.prreviewer.v1.GetTeamRequest\x1a\x1e.prreviewer.v1.GetTeamResponseBVZTgithub.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1;prreviewerv1b\x06proto3"
+
+var (
+	file_prreviewer_v1_team_service_proto_rawDescOnce sync.Once
+	file_prreviewer_v1_team_service_proto_rawDescData []byte
+)
+
+func file_prreviewer_v1_team_service_proto_rawDescGZIP() []byte {
+	file_prreviewer_v1_team_service_proto_rawDescOnce.Do(func() {
+		file_prreviewer_v1_team_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prreviewer_v1_team_service_proto_rawDesc), len(file_prreviewer_v1_team_service_proto_rawDesc)))
+	})
+	return file_prreviewer_v1_team_service_proto_rawDescData
+}
+
+var file_prreviewer_v1_team_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_prreviewer_v1_team_service_proto_goTypes = []any{
+	(*AddTeamRequest)(nil),  // 0: prreviewer.v1.AddTeamRequest
+	(*AddTeamResponse)(nil), // 1: prreviewer.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),  // 2: prreviewer.v1.GetTeamRequest
+	(*GetTeamResponse)(nil), // 3: prreviewer.v1.GetTeamResponse
+	(*Team)(nil),            // 4: prreviewer.v1.Team
+}
+var file_prreviewer_v1_team_service_proto_depIdxs = []int32{
+	4, // 0: prreviewer.v1.AddTeamRequest.team:type_name -> prreviewer.v1.Team
+	4, // 1: prreviewer.v1.AddTeamResponse.team:type_name -> prreviewer.v1.Team
+	4, // 2: prreviewer.v1.GetTeamResponse.team:type_name -> prreviewer.v1.Team
+	0, // 3: prreviewer.v1.TeamService.AddTeam:input_type -> prreviewer.v1.AddTeamRequest
+	2, // 4: prreviewer.v1.TeamService.GetTeam:input_type -> prreviewer.v1.GetTeamRequest
+	1, // 5: prreviewer.v1.TeamService.AddTeam:output_type -> prreviewer.v1.AddTeamResponse
+	3, // 6: prreviewer.v1.TeamService.GetTeam:output_type -> prreviewer.v1.GetTeamResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_prreviewer_v1_team_service_proto_init() }
+func file_prreviewer_v1_team_service_proto_init() {
+	if File_prreviewer_v1_team_service_proto != nil {
+		return
+	}
+	file_prreviewer_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prreviewer_v1_team_service_proto_rawDesc), len(file_prreviewer_v1_team_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prreviewer_v1_team_service_proto_goTypes,
+		DependencyIndexes: file_prreviewer_v1_team_service_proto_depIdxs,
+		MessageInfos:      file_prreviewer_v1_team_service_proto_msgTypes,
+	}.Build()
+	File_prreviewer_v1_team_service_proto = out.File
+	file_prreviewer_v1_team_service_proto_goTypes = nil
+	file_prreviewer_v1_team_service_proto_depIdxs = nil
+}
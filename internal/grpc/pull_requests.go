@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type pullRequestServer struct {
+	pb.UnimplementedPullRequestServiceServer
+	prService service.PullRequestService
+}
+
+func (s *pullRequestServer) CreatePullRequest(ctx context.Context, req *pb.CreatePullRequestRequest) (*pb.CreatePullRequestResponse, error) {
+	pr, err := s.prService.CreatePullRequest(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePullRequestResponse{PullRequest: pullRequestToPB(pr)}, nil
+}
+
+func (s *pullRequestServer) GetPullRequest(ctx context.Context, req *pb.GetPullRequestRequest) (*pb.GetPullRequestResponse, error) {
+	pr, err := s.prService.GetPullRequest(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPullRequestResponse{PullRequest: pullRequestToPB(pr)}, nil
+}
+
+func (s *pullRequestServer) MergePullRequest(ctx context.Context, req *pb.MergePullRequestRequest) (*pb.MergePullRequestResponse, error) {
+	pr, err := s.prService.MergePullRequest(ctx, req.GetPullRequestId(), req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MergePullRequestResponse{PullRequest: pullRequestToPB(pr)}, nil
+}
+
+func (s *pullRequestServer) ReassignReviewer(ctx context.Context, req *pb.ReassignReviewerRequest) (*pb.ReassignReviewerResponse, error) {
+	pr, replacedBy, err := s.prService.ReAssignPullRequest(ctx, req.GetPullRequestId(), req.GetOldUserId(), req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReassignReviewerResponse{PullRequest: pullRequestToPB(pr), ReplacedBy: replacedBy}, nil
+}
+
+func (s *pullRequestServer) MarkReviewed(ctx context.Context, req *pb.MarkReviewedRequest) (*pb.MarkReviewedResponse, error) {
+	pr, err := s.prService.MarkReviewed(ctx, req.GetPullRequestId(), req.GetReviewerId(), req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarkReviewedResponse{PullRequest: pullRequestToPB(pr)}, nil
+}
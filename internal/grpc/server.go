@@ -0,0 +1,108 @@
+// Package grpc реализует gRPC API сервиса. Методы вызывают те же экземпляры
+// сервисов, что и HTTP-обработчики, поэтому права, аудит и валидация общие.
+// Ошибки сервисов переводятся в статусы gRPC в toStatus. Определения protobuf
+// лежат в api/proto, сгенерированный код — в пакете prreviewerv1.
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Services — сервисы, которые публикуются по gRPC.
+type Services struct {
+	Teams        service.TeamService
+	Users        service.UserService
+	PullRequests service.PullRequestService
+	Stats        service.StatsService
+}
+
+type Server struct {
+	server *grpc.Server
+	health *health.Server
+}
+
+// NewServer регистрирует сервисы, grpc.health.v1 и, если включено, reflection.
+// authenticator == nil отключает проверку токенов (auth.enabled = false): вызовы
+// выполняются от имени auth.Anonymous().
+func NewServer(cfg config.GRPCConfig, services Services, authenticator auth.Authenticator, tp trace.TracerProvider, logger *slog.Logger) *Server {
+	unary := []grpc.UnaryServerInterceptor{
+		requestIDInterceptor,
+		statusInterceptor,
+		tracingInterceptor(tp),
+		accessLogInterceptor(logger),
+		recoverInterceptor(logger),
+	}
+	var stream []grpc.StreamServerInterceptor
+	if authenticator != nil {
+		unary = append(unary, authInterceptor(authenticator))
+		stream = append(stream, authStreamInterceptor(authenticator))
+	} else {
+		unary = append(unary, assumeIdentityInterceptor(auth.Anonymous()))
+		stream = append(stream, assumeIdentityStreamInterceptor(auth.Anonymous()))
+	}
+
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(cfg.MaxRecvBytes),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+
+	pb.RegisterTeamServiceServer(server, &teamServer{teamService: services.Teams})
+	pb.RegisterUserServiceServer(server, &userServer{userService: services.Users})
+	pb.RegisterPullRequestServiceServer(server, &pullRequestServer{prService: services.PullRequests})
+	pb.RegisterStatsServiceServer(server, &statsServer{statsService: services.Stats})
+
+	healthServer := health.NewServer()
+	for name := range server.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	if cfg.Reflection {
+		reflection.Register(server)
+	}
+
+	return &Server{server: server, health: healthServer}
+}
+
+// Serve принимает соединения до вызова Stop.
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// SetNotServing переводит health-сервис в NOT_SERVING — аналог /readyz при остановке.
+func (s *Server) SetNotServing() {
+	s.health.Shutdown()
+}
+
+// Stop дожидается завершения активных вызовов, а по истечении ctx обрывает их.
+func (s *Server) Stop(ctx context.Context) error {
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		<-done
+		return ctx.Err()
+	}
+}
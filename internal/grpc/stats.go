@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type statsServer struct {
+	pb.UnimplementedStatsServiceServer
+	statsService service.StatsService
+}
+
+func (s *statsServer) GetReviewerStats(ctx context.Context, req *pb.GetReviewerStatsRequest) (*pb.GetReviewerStatsResponse, error) {
+	stats, err := s.statsService.GetReviewerStats(ctx, statsFilterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetReviewerStatsResponse{Items: make([]*pb.ReviewerStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Items = append(resp.Items, &pb.ReviewerStats{
+			UserId:        st.UserID,
+			Username:      st.UserName,
+			AssignedCount: st.AssignedCount,
+			OpenCount:     st.OpenCount,
+			MergedCount:   st.MergedCount,
+		})
+	}
+	return resp, nil
+}
+
+func (s *statsServer) GetTeamStats(ctx context.Context, req *pb.GetTeamStatsRequest) (*pb.GetTeamStatsResponse, error) {
+	stats, err := s.statsService.GetTeamStats(ctx, statsFilterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetTeamStatsResponse{Items: make([]*pb.TeamStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Items = append(resp.Items, &pb.TeamStats{
+			TeamName:      st.TeamName,
+			AssignedCount: st.AssignedCount,
+			MergedCount:   st.MergedCount,
+			MembersCount:  st.MembersCount,
+			ActiveMembers: st.ActiveMembers,
+		})
+	}
+	return resp, nil
+}
+
+func (s *statsServer) GetLatency(ctx context.Context, req *pb.GetLatencyRequest) (*pb.GetLatencyResponse, error) {
+	report, err := s.statsService.GetLatency(ctx, statsFilterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+	return latencyToPB(report), nil
+}
+
+func (s *statsServer) GetFairness(ctx context.Context, req *pb.GetFairnessRequest) (*pb.GetFairnessResponse, error) {
+	teams, err := s.statsService.GetFairness(ctx, statsFilterFromPB(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+	return fairnessToPB(teams), nil
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type teamServer struct {
+	pb.UnimplementedTeamServiceServer
+	teamService service.TeamService
+}
+
+func (s *teamServer) AddTeam(ctx context.Context, req *pb.AddTeamRequest) (*pb.AddTeamResponse, error) {
+	created, err := s.teamService.Add(ctx, teamFromPB(req.GetTeam()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddTeamResponse{Team: teamToPB(created)}, nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
+	team, err := s.teamService.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTeamResponse{Team: teamToPB(team)}, nil
+}
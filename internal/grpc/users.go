@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/blumgardt/pr-reviewer-service.git/internal/grpc/prreviewerv1"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	userService service.UserService
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	user, err := s.userService.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserResponse{User: userToPB(user)}, nil
+}
+
+func (s *userServer) SetIsActive(ctx context.Context, req *pb.SetIsActiveRequest) (*pb.SetIsActiveResponse, error) {
+	user, err := s.userService.SetIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetIsActiveResponse{User: userToPB(user)}, nil
+}
+
+func (s *userServer) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewResponse, error) {
+	prs, err := s.userService.GetReview(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*pb.PullRequestShort, 0, len(prs)),
+	}
+	for i := range prs {
+		resp.PullRequests = append(resp.PullRequests, pullRequestShortToPB(&prs[i]))
+	}
+	return resp, nil
+}
+
+func (s *userServer) GetMe(ctx context.Context, _ *pb.GetMeRequest) (*pb.GetMeResponse, error) {
+	user, err := s.userService.Me(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMeResponse{User: userToPB(user)}, nil
+}
@@ -0,0 +1,82 @@
+// Package health собирает проверки готовности сервиса для /readyz.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const checkTimeout = 2 * time.Second
+
+type CheckFunc func(ctx context.Context) error
+
+type CheckResult struct {
+	Name  string
+	Error error
+}
+
+type Report struct {
+	Ready        bool
+	ShuttingDown bool
+	Checks       []CheckResult
+}
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Probe хранит зарегистрированные проверки и флаг остановки. После
+// SetShuttingDown сервис считается не готовым независимо от проверок.
+type Probe struct {
+	checks       []check
+	shuttingDown atomic.Bool
+}
+
+func NewProbe() *Probe {
+	return &Probe{}
+}
+
+// AddCheck регистрирует проверку; вызывается до старта сервера.
+func (p *Probe) AddCheck(name string, fn CheckFunc) {
+	p.checks = append(p.checks, check{name: name, fn: fn})
+}
+
+func (p *Probe) SetShuttingDown() {
+	p.shuttingDown.Store(true)
+}
+
+func (p *Probe) ShuttingDown() bool {
+	return p.shuttingDown.Load()
+}
+
+// Ready выполняет все проверки параллельно, каждую со своим таймаутом.
+func (p *Probe) Ready(ctx context.Context) Report {
+	report := Report{
+		ShuttingDown: p.ShuttingDown(),
+		Checks:       make([]CheckResult, len(p.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, c := range p.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			report.Checks[i] = CheckResult{Name: c.name, Error: c.fn(checkCtx)}
+		}()
+	}
+	wg.Wait()
+
+	report.Ready = !report.ShuttingDown
+	for _, c := range report.Checks {
+		if c.Error != nil {
+			report.Ready = false
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,10 @@
+package dto
+
+type RestoreResponse struct {
+	TeamsCount        int `json:"teams_count"`
+	UsersCount        int `json:"users_count"`
+	UserRolesCount    int `json:"user_roles_count"`
+	PullRequestsCount int `json:"pull_requests_count"`
+	ReviewersCount    int `json:"reviewers_count"`
+	ActivityLogCount  int `json:"activity_log_count"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// DataResponse — конверт успешных ответов /api/v2: ресурс или список лежит в data.
+// Ошибки приходят в том же формате ErrorResponse, что и в v1.
+type DataResponse struct {
+	Data any `json:"data"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type AssignmentDTO struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	ReviewerID      string     `json:"reviewer_id"`
+	ReviewerTeam    string     `json:"reviewer_team"`
+	AssignedAt      time.Time  `json:"assigned_at"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+}
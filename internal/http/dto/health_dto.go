@@ -0,0 +1,25 @@
+package dto
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// ReadinessCheckDTO — результат проверки без текста ошибки: /readyz публичный,
+// а ошибки подключения к БД и миграций раскрывают адреса и схему.
+type ReadinessCheckDTO struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Status string              `json:"status"`
+	Checks []ReadinessCheckDTO `json:"checks"`
+}
+
+type VersionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+	Modified  bool   `json:"modified"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+type ImportRowErrorDTO struct {
+	Section string `json:"section,omitempty"`
+	Row     int    `json:"row,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportResponse struct {
+	DryRun            bool                `json:"dry_run"`
+	Applied           bool                `json:"applied"`
+	TeamsCount        int                 `json:"teams_count"`
+	UsersCount        int                 `json:"users_count"`
+	PullRequestsCount int                 `json:"pull_requests_count"`
+	ReviewersCount    int                 `json:"reviewers_count"`
+	Errors            []ImportRowErrorDTO `json:"errors"`
+}
@@ -0,0 +1,30 @@
+package mapping
+
+import (
+	"strconv"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+// MapAuditToDTO заполняет next_cursor, только если страница заполнена целиком.
+func MapAuditToDTO(entries []domain.AuditEntry, limit int) dto.AuditResponse {
+	resp := dto.AuditResponse{Entries: make([]dto.AuditEntryDTO, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, dto.AuditEntryDTO{
+			ID:         e.ID,
+			OccurredAt: e.OccurredAt,
+			Actor:      e.Actor,
+			Action:     e.Action,
+			EntityType: e.EntityType,
+			EntityID:   e.EntityID,
+			Before:     e.Before,
+			After:      e.After,
+			RequestID:  e.RequestID,
+		})
+	}
+	if len(entries) > 0 && len(entries) == limit {
+		resp.NextCursor = strconv.FormatInt(entries[len(entries)-1].ID, 10)
+	}
+	return resp
+}
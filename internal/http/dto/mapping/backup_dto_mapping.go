@@ -0,0 +1,17 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapRestoreResultToDTO(res *domain.RestoreResult) dto.RestoreResponse {
+	return dto.RestoreResponse{
+		TeamsCount:        res.TeamsCount,
+		UsersCount:        res.UsersCount,
+		UserRolesCount:    res.UserRolesCount,
+		PullRequestsCount: res.PullRequestsCount,
+		ReviewersCount:    res.ReviewersCount,
+		ActivityLogCount:  res.ActivityLogCount,
+	}
+}
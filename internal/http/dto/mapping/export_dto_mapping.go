@@ -0,0 +1,19 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapDomainAssignmentToDTO(a domain.Assignment) dto.AssignmentDTO {
+	return dto.AssignmentDTO{
+		PullRequestID:   a.PullRequestID,
+		PullRequestName: a.PullRequestName,
+		AuthorID:        a.AuthorID,
+		Status:          a.Status,
+		ReviewerID:      a.ReviewerID,
+		ReviewerTeam:    a.ReviewerTeam,
+		AssignedAt:      a.AssignedAt,
+		ReviewedAt:      a.ReviewedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/buildinfo"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/health"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapReadinessReportToDTO(report health.Report) dto.ReadinessResponse {
+	resp := dto.ReadinessResponse{
+		Status: "ok",
+		Checks: make([]dto.ReadinessCheckDTO, 0, len(report.Checks)+1),
+	}
+
+	if report.ShuttingDown {
+		resp.Checks = append(resp.Checks, dto.ReadinessCheckDTO{Name: "shutdown", Status: "fail"})
+	}
+
+	for _, c := range report.Checks {
+		item := dto.ReadinessCheckDTO{Name: c.Name, Status: "ok"}
+		if c.Error != nil {
+			item.Status = "fail"
+		}
+		resp.Checks = append(resp.Checks, item)
+	}
+
+	if !report.Ready {
+		resp.Status = "fail"
+	}
+
+	return resp
+}
+
+func MapBuildInfoToDTO(info buildinfo.Info) dto.VersionResponse {
+	return dto.VersionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildTime: info.BuildTime,
+		GoVersion: info.GoVersion,
+		Modified:  info.Modified,
+	}
+}
@@ -0,0 +1,27 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapImportResultToDTO(res *domain.ImportResult) dto.ImportResponse {
+	resp := dto.ImportResponse{
+		DryRun:            res.DryRun,
+		Applied:           res.Applied,
+		TeamsCount:        res.TeamsCount,
+		UsersCount:        res.UsersCount,
+		PullRequestsCount: res.PullRequestsCount,
+		ReviewersCount:    res.ReviewersCount,
+		Errors:            make([]dto.ImportRowErrorDTO, 0, len(res.Errors)),
+	}
+	for _, e := range res.Errors {
+		resp.Errors = append(resp.Errors, dto.ImportRowErrorDTO{
+			Section: e.Section,
+			Row:     e.Row,
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return resp
+}
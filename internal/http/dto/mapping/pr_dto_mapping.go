@@ -14,6 +14,7 @@ func MapDomainPRToDTO(pr *domain.PullRequest) dto.PullRequestDTO {
 		AssignedReviewers: pr.ReviewersID,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		Version:           pr.Version,
 	}
 }
 
@@ -0,0 +1,38 @@
+package mapping
+
+import (
+	"strconv"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+// MapReviewEventToDTO формирует id события для Last-Event-ID: "T-N" для
+// последнего события записи журнала N из транзакции T, иначе "T-N.k".
+func MapReviewEventToDTO(ev domain.ReviewEvent) dto.ReviewEventDTO {
+	id := strconv.FormatInt(ev.TxID, 10) + "-" + strconv.FormatInt(ev.ID, 10)
+	if !ev.Last {
+		id += "." + strconv.Itoa(ev.Seq)
+	}
+
+	reviewers := ev.Reviewers
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	return dto.ReviewEventDTO{
+		ID:                id,
+		Type:              string(ev.Type),
+		OccurredAt:        ev.OccurredAt,
+		Actor:             ev.Actor,
+		PullRequestID:     ev.PullRequestID,
+		PullRequestName:   ev.PullRequestName,
+		AuthorID:          ev.AuthorID,
+		TeamName:          ev.TeamName,
+		Status:            ev.Status,
+		AssignedReviewers: reviewers,
+		ReviewerID:        ev.ReviewerID,
+		OldReviewerID:     ev.OldReviewerID,
+		NewReviewerID:     ev.NewReviewerID,
+	}
+}
@@ -0,0 +1,40 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapRolesToDTO(roles []domain.Role) dto.ListRolesResponse {
+	resp := dto.ListRolesResponse{Roles: make([]dto.RoleDTO, 0, len(roles))}
+	for _, r := range roles {
+		perms := make([]string, 0, len(r.Permissions))
+		for _, p := range r.Permissions {
+			perms = append(perms, string(p))
+		}
+		resp.Roles = append(resp.Roles, dto.RoleDTO{
+			Name:        r.Name,
+			Description: r.Description,
+			IsDefault:   r.IsDefault,
+			Permissions: perms,
+		})
+	}
+	return resp
+}
+
+func MapRoleAssignmentToDTO(a *domain.RoleAssignment) dto.RoleAssignmentDTO {
+	return dto.RoleAssignmentDTO{
+		UserID:    a.UserID,
+		Role:      a.Role,
+		TeamName:  a.TeamName,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func MapRoleAssignmentsToDTO(assignments []domain.RoleAssignment) dto.ListRoleAssignmentsResponse {
+	resp := dto.ListRoleAssignmentsResponse{Assignments: make([]dto.RoleAssignmentDTO, 0, len(assignments))}
+	for i := range assignments {
+		resp.Assignments = append(resp.Assignments, MapRoleAssignmentToDTO(&assignments[i]))
+	}
+	return resp
+}
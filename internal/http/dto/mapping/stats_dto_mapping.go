@@ -10,11 +10,115 @@ func ReviewerStatsToDTO(stats []domain.ReviewerStats) dto.ReviewerStatsResponse
 		Items: make([]dto.ReviewerStatsItem, 0, len(stats)),
 	}
 	for _, s := range stats {
-		resp.Items = append(resp.Items, dto.ReviewerStatsItem{
-			UserID:        s.UserID,
-			Username:      s.UserName,
+		resp.Items = append(resp.Items, ReviewerStatsItemToDTO(s))
+	}
+	return resp
+}
+
+func ReviewerStatsItemToDTO(s domain.ReviewerStats) dto.ReviewerStatsItem {
+	return dto.ReviewerStatsItem{
+		UserID:        s.UserID,
+		Username:      s.UserName,
+		AssignedCount: s.AssignedCount,
+		OpenCount:     s.OpenCount,
+		MergedCount:   s.MergedCount,
+	}
+}
+
+func TeamStatsToDTO(stats []domain.TeamStats) dto.TeamStatsResponse {
+	resp := dto.TeamStatsResponse{
+		Items: make([]dto.TeamStatsItem, 0, len(stats)),
+	}
+	for _, s := range stats {
+		resp.Items = append(resp.Items, dto.TeamStatsItem{
+			TeamName:      s.TeamName,
 			AssignedCount: s.AssignedCount,
+			MergedCount:   s.MergedCount,
+			MembersCount:  s.MembersCount,
+			ActiveMembers: s.ActiveMembers,
+		})
+	}
+	return resp
+}
+
+func LatencyReportToDTO(report *domain.LatencyReport) dto.LatencyStatsResponse {
+	resp := dto.LatencyStatsResponse{
+		TimeToMerge: dto.TimeToMergeDTO{
+			ByTeam:   make([]dto.TeamLatencyItem, 0, len(report.TimeToMergeByTeam)),
+			ByAuthor: make([]dto.AuthorLatencyItem, 0, len(report.TimeToMergeByAuthor)),
+			Weekly:   make([]dto.LatencyTrendItem, 0, len(report.TimeToMergeWeeklyTrend)),
+		},
+		TimeToFirstReview: dto.TimeToFirstReviewDTO{
+			ByReviewer: make([]dto.ReviewerLatencyItem, 0, len(report.TimeToFirstReview)),
+		},
+	}
+
+	for _, l := range report.TimeToMergeByTeam {
+		resp.TimeToMerge.ByTeam = append(resp.TimeToMerge.ByTeam, dto.TeamLatencyItem{
+			TeamName:         l.TeamName,
+			DurationStatsDTO: durationStatsToDTO(l.DurationStats),
 		})
 	}
+	for _, l := range report.TimeToMergeByAuthor {
+		resp.TimeToMerge.ByAuthor = append(resp.TimeToMerge.ByAuthor, dto.AuthorLatencyItem{
+			UserID:           l.UserID,
+			Username:         l.UserName,
+			TeamName:         l.TeamName,
+			DurationStatsDTO: durationStatsToDTO(l.DurationStats),
+		})
+	}
+	for _, b := range report.TimeToMergeWeeklyTrend {
+		resp.TimeToMerge.Weekly = append(resp.TimeToMerge.Weekly, dto.LatencyTrendItem{
+			WeekStart:        b.WeekStart,
+			DurationStatsDTO: durationStatsToDTO(b.DurationStats),
+		})
+	}
+	for _, l := range report.TimeToFirstReview {
+		resp.TimeToFirstReview.ByReviewer = append(resp.TimeToFirstReview.ByReviewer, dto.ReviewerLatencyItem{
+			UserID:           l.UserID,
+			Username:         l.UserName,
+			DurationStatsDTO: durationStatsToDTO(l.DurationStats),
+		})
+	}
+
+	return resp
+}
+
+func durationStatsToDTO(s domain.DurationStats) dto.DurationStatsDTO {
+	return dto.DurationStatsDTO{
+		Count:      s.Count,
+		P50Seconds: s.P50,
+		P90Seconds: s.P90,
+		P99Seconds: s.P99,
+	}
+}
+
+func FairnessToDTO(teams []domain.TeamFairness) dto.FairnessStatsResponse {
+	resp := dto.FairnessStatsResponse{
+		Items: make([]dto.TeamFairnessItem, 0, len(teams)),
+	}
+	for _, t := range teams {
+		item := dto.TeamFairnessItem{
+			TeamName:      t.TeamName,
+			From:          t.From,
+			To:            t.To,
+			TotalAssigned: t.TotalAssigned,
+			Gini:          t.Gini,
+			Members:       make([]dto.MemberFairnessItem, 0, len(t.Members)),
+		}
+		for _, m := range t.Members {
+			item.Members = append(item.Members, dto.MemberFairnessItem{
+				UserID:        m.UserID,
+				Username:      m.UserName,
+				AssignedCount: m.AssignedCount,
+				ActiveRatio:   m.ActiveRatio,
+				Share:         m.Share,
+				ExpectedShare: m.ExpectedShare,
+				LoadRatio:     m.LoadRatio,
+				Outlier:       string(m.Outlier),
+			})
+		}
+		resp.Items = append(resp.Items, item)
+	}
 	return resp
 }
@@ -0,0 +1,27 @@
+package mapping
+
+import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+)
+
+func MapTokenToDTO(t *domain.APIToken) dto.TokenDTO {
+	return dto.TokenDTO{
+		TokenID:    t.ID,
+		Name:       t.Name,
+		UserID:     t.UserID,
+		IsAdmin:    t.IsAdmin,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		RevokedAt:  t.RevokedAt,
+	}
+}
+
+func MapTokensToDTO(tokens []domain.APIToken) dto.ListTokensResponse {
+	resp := dto.ListTokensResponse{Tokens: make([]dto.TokenDTO, 0, len(tokens))}
+	for i := range tokens {
+		resp.Tokens = append(resp.Tokens, MapTokenToDTO(&tokens[i]))
+	}
+	return resp
+}
@@ -1,6 +1,7 @@
 package mapping
 
 import (
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
 )
@@ -13,3 +14,17 @@ func MapDomainUserToDTO(u *domain.User) dto.UserDTO {
 		IsActive: u.IsActive,
 	}
 }
+
+func MapIdentityToMeResponse(id *auth.Identity, u *domain.User) dto.MeResponse {
+	resp := dto.MeResponse{
+		Subject:     id.Subject,
+		User:        MapDomainUserToDTO(u),
+		TokenID:     id.TokenID,
+		IsAdmin:     id.IsAdmin,
+		Permissions: make([]dto.GrantDTO, 0, len(id.Grants)),
+	}
+	for _, g := range id.Grants {
+		resp.Permissions = append(resp.Permissions, dto.GrantDTO{Permission: string(g.Permission), TeamName: g.TeamName})
+	}
+	return resp
+}
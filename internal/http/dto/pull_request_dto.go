@@ -12,6 +12,8 @@ type PullRequestDTO struct {
 	AssignedReviewers []string   `json:"assigned_reviewers"`
 	CreatedAt         time.Time  `json:"createdAt,omitempty"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	// Version совпадает с ETag ответа и передаётся в If-Match при изменении PR.
+	Version int64 `json:"version"`
 }
 
 type PullRequestShortDTO struct {
@@ -31,6 +33,10 @@ type CreatePullRequestResponse struct {
 	PR PullRequestDTO `json:"pr"`
 }
 
+type GetPullRequestResponse struct {
+	PR PullRequestDTO `json:"pr"`
+}
+
 type MergePullRequestRequest struct {
 	PullRequestID string `json:"pull_request_id"`
 }
@@ -48,3 +54,18 @@ type ReassignPullRequestResponse struct {
 	PR         PullRequestDTO `json:"pr"`
 	ReplacedBy string         `json:"replaced_by"`
 }
+
+// ReassignResultDTO — результат переназначения в /api/v2.
+type ReassignResultDTO struct {
+	PullRequest PullRequestDTO `json:"pull_request"`
+	ReplacedBy  string         `json:"replaced_by"`
+}
+
+type ReviewPullRequestRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+type ReviewPullRequestResponse struct {
+	PR PullRequestDTO `json:"pr"`
+}
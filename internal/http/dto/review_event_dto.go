@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+// ReviewEventDTO — данные события потока /events/stream. Тип события передаётся
+// в поле event SSE и дублируется в type.
+type ReviewEventDTO struct {
+	ID                string    `json:"id" example:"7391-42.1"`
+	Type              string    `json:"type" example:"pr.assigned"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	Actor             string    `json:"actor"`
+	PullRequestID     string    `json:"pull_request_id"`
+	PullRequestName   string    `json:"pull_request_name"`
+	AuthorID          string    `json:"author_id"`
+	TeamName          string    `json:"team_name,omitempty"`
+	Status            string    `json:"status"`
+	AssignedReviewers []string  `json:"assigned_reviewers"`
+	// ReviewerID заполняется для pr.assigned.
+	ReviewerID string `json:"reviewer_id,omitempty"`
+	// OldReviewerID и NewReviewerID заполняются для pr.reassigned.
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type RoleDTO struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	IsDefault   bool     `json:"is_default"`
+	Permissions []string `json:"permissions"`
+}
+
+type ListRolesResponse struct {
+	Roles []RoleDTO `json:"roles"`
+}
+
+type RoleAssignmentDTO struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	TeamName  string    `json:"team_name,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListRoleAssignmentsResponse struct {
+	Assignments []RoleAssignmentDTO `json:"assignments"`
+}
+
+// RoleAssignmentRequest — тело /roles/assign и /roles/unassign. Пустой team_name — глобальная роль.
+type RoleAssignmentRequest struct {
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	TeamName string `json:"team_name"`
+}
+
+type RoleAssignmentResponse struct {
+	Assignment RoleAssignmentDTO `json:"assignment"`
+}
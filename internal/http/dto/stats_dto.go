@@ -1,11 +1,96 @@
 package dto
 
+import "time"
+
 type ReviewerStatsItem struct {
 	UserID        string `json:"user_id"`
 	Username      string `json:"username"`
 	AssignedCount int64  `json:"assigned_count"`
+	OpenCount     int64  `json:"open_count"`
+	MergedCount   int64  `json:"merged_count"`
 }
 
 type ReviewerStatsResponse struct {
 	Items []ReviewerStatsItem `json:"items"`
 }
+
+type TeamStatsItem struct {
+	TeamName      string `json:"team_name"`
+	AssignedCount int64  `json:"assigned_count"`
+	MergedCount   int64  `json:"merged_count"`
+	MembersCount  int64  `json:"members_count"`
+	ActiveMembers int64  `json:"active_members"`
+}
+
+type TeamStatsResponse struct {
+	Items []TeamStatsItem `json:"items"`
+}
+
+type DurationStatsDTO struct {
+	Count      int64   `json:"count"`
+	P50Seconds float64 `json:"p50_seconds"`
+	P90Seconds float64 `json:"p90_seconds"`
+	P99Seconds float64 `json:"p99_seconds"`
+}
+
+type TeamLatencyItem struct {
+	TeamName string `json:"team_name"`
+	DurationStatsDTO
+}
+
+type AuthorLatencyItem struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	DurationStatsDTO
+}
+
+type ReviewerLatencyItem struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	DurationStatsDTO
+}
+
+type LatencyTrendItem struct {
+	WeekStart time.Time `json:"week_start"`
+	DurationStatsDTO
+}
+
+type TimeToMergeDTO struct {
+	ByTeam   []TeamLatencyItem   `json:"by_team"`
+	ByAuthor []AuthorLatencyItem `json:"by_author"`
+	Weekly   []LatencyTrendItem  `json:"weekly"`
+}
+
+type TimeToFirstReviewDTO struct {
+	ByReviewer []ReviewerLatencyItem `json:"by_reviewer"`
+}
+
+type LatencyStatsResponse struct {
+	TimeToMerge       TimeToMergeDTO       `json:"time_to_merge"`
+	TimeToFirstReview TimeToFirstReviewDTO `json:"time_to_first_review"`
+}
+
+type MemberFairnessItem struct {
+	UserID        string  `json:"user_id"`
+	Username      string  `json:"username"`
+	AssignedCount int64   `json:"assigned_count"`
+	ActiveRatio   float64 `json:"active_ratio"`
+	Share         float64 `json:"share"`
+	ExpectedShare float64 `json:"expected_share"`
+	LoadRatio     float64 `json:"load_ratio"`
+	Outlier       string  `json:"outlier,omitempty"`
+}
+
+type TeamFairnessItem struct {
+	TeamName      string               `json:"team_name"`
+	From          time.Time            `json:"from"`
+	To            time.Time            `json:"to"`
+	TotalAssigned int64                `json:"total_assigned"`
+	Gini          float64              `json:"gini"`
+	Members       []MemberFairnessItem `json:"members"`
+}
+
+type FairnessStatsResponse struct {
+	Items []TeamFairnessItem `json:"items"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+type TokenDTO struct {
+	TokenID    string     `json:"token_id"`
+	Name       string     `json:"name"`
+	UserID     string     `json:"user_id,omitempty"`
+	IsAdmin    bool       `json:"is_admin"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type CreateTokenRequest struct {
+	Name      string     `json:"name"`
+	UserID    string     `json:"user_id"`
+	IsAdmin   bool       `json:"is_admin"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type CreateTokenResponse struct {
+	// Token — значение для заголовка Authorization: Bearer; повторно не выдаётся.
+	Token     string   `json:"token"`
+	TokenInfo TokenDTO `json:"token_info"`
+}
+
+type ListTokensResponse struct {
+	Tokens []TokenDTO `json:"tokens"`
+}
+
+type RevokeTokenRequest struct {
+	TokenID string `json:"token_id"`
+}
+
+type RevokeTokenResponse struct {
+	TokenInfo TokenDTO `json:"token_info"`
+}
@@ -12,6 +12,11 @@ type SetIsActiveRequest struct {
 	IsActive bool   `json:"is_active"`
 }
 
+// UpdateUserRequest — тело PATCH /api/v2/users/{id}.
+type UpdateUserRequest struct {
+	IsActive *bool `json:"is_active"`
+}
+
 type SetIsActiveResponse struct {
 	User UserDTO `json:"user"`
 }
@@ -20,3 +25,17 @@ type GetReviewResponse struct {
 	UserID       string                `json:"user_id"`
 	PullRequests []PullRequestShortDTO `json:"pull_requests"`
 }
+
+type GrantDTO struct {
+	Permission string `json:"permission"`
+	TeamName   string `json:"team_name,omitempty"`
+}
+
+type MeResponse struct {
+	// Subject — кем вызывающий записывается в логах и аудите.
+	Subject     string     `json:"subject"`
+	User        UserDTO    `json:"user"`
+	TokenID     string     `json:"token_id,omitempty"`
+	IsAdmin     bool       `json:"is_admin"`
+	Permissions []GrantDTO `json:"permissions"`
+}
@@ -0,0 +1,105 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type BackupHandler struct {
+	backupService service.BackupService
+}
+
+func NewBackupHandler(backupService service.BackupService) *BackupHandler {
+	return &BackupHandler{backupService: backupService}
+}
+
+// Backup godoc
+// @Summary      Полная выгрузка данных в архив
+// @Description  Потоково выгружает команды, пользователей, PR, ревьюверов и журнал активности в версионированный JSON-архив.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {file}    file
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403  {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
+// @Router       /admin/backup [get]
+func (h *BackupHandler) Backup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	filename := "pr-reviewer-backup-" + time.Now().UTC().Format("20060102T150405Z") + ".json"
+	out := &lazyWriter{w: w, filename: filename}
+
+	if err := h.backupService.Export(ctx, out); err != nil {
+		if out.started {
+			response.RecordError(w, err)
+		} else {
+			response.WriteError(w, err)
+		}
+	}
+}
+
+// Restore godoc
+// @Summary      Восстановление данных из архива
+// @Description  Загружает архив, полученный из /admin/backup или команды export, в пустую БД одной транзакцией.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      201  {object}  dto.RestoreResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION (невалидный архив или непустая БД)"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403  {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      409  {object}  response.ErrorResponse   "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      413  {object}  response.ErrorResponse   "PAYLOAD_TOO_LARGE"
+// @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
+// @Router       /admin/restore [post]
+func (h *BackupHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	result, err := h.backupService.Restore(ctx, r.Body)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.MapRestoreResultToDTO(result)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// lazyWriter отправляет заголовки ответа только при первой записи архива,
+// чтобы ошибку до начала выгрузки можно было вернуть как ErrorResponse.
+type lazyWriter struct {
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (lw *lazyWriter) Write(p []byte) (int, error) {
+	if !lw.started {
+		lw.started = true
+		lw.w.Header().Set("Content-Type", "application/json")
+		lw.w.Header().Set("Content-Disposition", `attachment; filename="`+lw.filename+`"`)
+		lw.w.WriteHeader(http.StatusOK)
+	}
+	return lw.w.Write(p)
+}
@@ -0,0 +1,36 @@
+// Package apiv2 реализует REST API /api/v2: ресурсы адресуются путём, действие —
+// HTTP-методом, маршруты регистрируются паттернами http.ServeMux с методом
+// (неподходящий метод ServeMux сам отклоняет с 405 и заголовком Allow).
+// Успешные ответы завёрнуты в dto.DataResponse, ошибки — response.ErrorResponse.
+package apiv2
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+// Prefix — общий префикс маршрутов v2.
+const Prefix = "/api/v2"
+
+type Handler struct {
+	teamService service.TeamService
+	userService service.UserService
+	prService   service.PullRequestService
+}
+
+func NewHandler(teamService service.TeamService, userService service.UserService, prService service.PullRequestService) *Handler {
+	return &Handler{
+		teamService: teamService,
+		userService: userService,
+		prService:   prService,
+	}
+}
+
+func writeData(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(dto.DataResponse{Data: data})
+}
@@ -0,0 +1,181 @@
+package apiv2
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+// CreatePullRequest godoc
+// @Summary      Создать PR
+// @Description  Создаёт pull request и назначает до двух активных ревьюверов из команды автора.
+// @Tags         v2 PullRequests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body             body      dto.CreatePullRequestRequest  true   "PR"
+// @Param        Idempotency-Key  header    string                        false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      201              {object}  dto.DataResponse{data=dto.PullRequestDTO}
+// @Header       201              {string}  ETag      "Версия PR"
+// @Header       201              {string}  Location  "/api/v2/pull-requests/{id}"
+// @Failure      400              {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401              {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403              {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      404              {object}  response.ErrorResponse  "NOT_FOUND (автор или команда)"
+// @Failure      409              {object}  response.ErrorResponse  "PR_EXISTS / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /api/v2/pull-requests [post]
+func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreatePullRequestRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	pr, err := h.prService.CreatePullRequest(r.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", Prefix+"/pull-requests/"+url.PathEscape(pr.PullRequestID))
+	writePullRequest(w, http.StatusCreated, pr)
+}
+
+// GetPullRequest godoc
+// @Summary      Получить PR
+// @Description  Возвращает PR с ревьюверами; версия — в ETag.
+// @Tags         v2 PullRequests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID PR"
+// @Success      200  {object}  dto.DataResponse{data=dto.PullRequestDTO}
+// @Header       200  {string}  ETag  "Версия PR"
+// @Failure      401  {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404  {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/pull-requests/{id} [get]
+func (h *Handler) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+	pr, err := h.prService.GetPullRequest(r.Context(), r.PathValue("id"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writePullRequest(w, http.StatusOK, pr)
+}
+
+// MergePullRequest godoc
+// @Summary      Смёржить PR
+// @Description  Переводит PR в MERGED. Повторный вызов не меняет PR.
+// @Tags         v2 PullRequests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id               path      string  true   "ID PR"
+// @Param        If-Match         header    string  false  "ETag PR; запрос выполнится, только если PR не менялся"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200              {object}  dto.DataResponse{data=dto.PullRequestDTO}
+// @Header       200              {string}  ETag  "Версия PR"
+// @Failure      400              {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401              {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403              {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      404              {object}  response.ErrorResponse  "NOT_FOUND"
+// @Failure      409              {object}  response.ErrorResponse  "CONCURRENT_UPDATE / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      412              {object}  response.ErrorResponse  "CONFLICT (PR изменился)"
+// @Router       /api/v2/pull-requests/{id}/merge [post]
+func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	pr, err := h.prService.MergePullRequest(r.Context(), r.PathValue("id"), expectedVersion)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writePullRequest(w, http.StatusOK, pr)
+}
+
+// ReassignReviewer godoc
+// @Summary      Переназначить ревьювера
+// @Description  Заменяет ревьювера другим активным участником его команды.
+// @Tags         v2 PullRequests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id               path      string  true   "ID PR"
+// @Param        user_id          path      string  true   "ID заменяемого ревьювера"
+// @Param        If-Match         header    string  false  "ETag PR; запрос выполнится, только если PR не менялся"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200              {object}  dto.DataResponse{data=dto.ReassignResultDTO}
+// @Header       200              {string}  ETag  "Версия PR"
+// @Failure      400              {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401              {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403              {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      404              {object}  response.ErrorResponse  "NOT_FOUND"
+// @Failure      409              {object}  response.ErrorResponse  "PR_MERGED / NOT_ASSIGNED / NO_CANDIDATE / CONCURRENT_UPDATE / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      412              {object}  response.ErrorResponse  "CONFLICT (PR изменился с версии из If-Match)"
+// @Router       /api/v2/pull-requests/{id}/reviewers/{user_id}/reassign [post]
+func (h *Handler) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	pr, replacedBy, err := h.prService.ReAssignPullRequest(r.Context(), r.PathValue("id"), r.PathValue("user_id"), expectedVersion)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", request.ETag(pr.Version))
+	writeData(w, http.StatusOK, dto.ReassignResultDTO{
+		PullRequest: mapping.MapDomainPRToDTO(pr),
+		ReplacedBy:  replacedBy,
+	})
+}
+
+// SubmitReview godoc
+// @Summary      Отметить ревью
+// @Description  Фиксирует время первого ревью ревьювера. Повторный вызов не меняет PR.
+// @Tags         v2 PullRequests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id           path      string  true   "ID PR"
+// @Param        reviewer_id  path      string  true   "ID ревьювера"
+// @Param        If-Match     header    string  false  "ETag PR; запрос выполнится, только если PR не менялся"
+// @Success      200          {object}  dto.DataResponse{data=dto.PullRequestDTO}
+// @Header       200          {string}  ETag  "Версия PR"
+// @Failure      400          {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401          {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403          {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      404          {object}  response.ErrorResponse  "NOT_FOUND"
+// @Failure      409          {object}  response.ErrorResponse  "NOT_ASSIGNED"
+// @Failure      412          {object}  response.ErrorResponse  "CONFLICT (PR изменился)"
+// @Router       /api/v2/pull-requests/{id}/reviews/{reviewer_id} [put]
+func (h *Handler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	pr, err := h.prService.MarkReviewed(r.Context(), r.PathValue("id"), r.PathValue("reviewer_id"), expectedVersion)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writePullRequest(w, http.StatusOK, pr)
+}
+
+func writePullRequest(w http.ResponseWriter, status int, pr *domain.PullRequest) {
+	w.Header().Set("ETag", request.ETag(pr.Version))
+	writeData(w, status, mapping.MapDomainPRToDTO(pr))
+}
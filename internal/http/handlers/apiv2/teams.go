@@ -0,0 +1,92 @@
+package apiv2
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+// CreateTeam godoc
+// @Summary      Создать команду с участниками
+// @Description  Создаёт команду; существующие пользователи обновляются и переводятся в неё.
+// @Tags         v2 Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body             body      dto.TeamDTO  true   "Команда и участники"
+// @Param        Idempotency-Key  header    string       false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      201              {object}  dto.DataResponse{data=dto.TeamDTO}
+// @Header       201              {string}  Location  "/api/v2/teams/{name}"
+// @Failure      400              {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401              {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403              {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      409              {object}  response.ErrorResponse  "TEAM_EXISTS / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /api/v2/teams [post]
+func (h *Handler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	var req dto.TeamDTO
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	created, err := h.teamService.Add(r.Context(), mapping.MapTeamDTOToDomain(&req))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", Prefix+"/teams/"+url.PathEscape(created.Name))
+	writeData(w, http.StatusCreated, mapping.MapDomainTeamToDTO(created))
+}
+
+// GetTeam godoc
+// @Summary      Получить команду
+// @Description  Возвращает команду и её участников.
+// @Tags         v2 Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        name  path      string  true  "Имя команды"
+// @Success      200   {object}  dto.DataResponse{data=dto.TeamDTO}
+// @Failure      401   {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404   {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/teams/{name} [get]
+func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
+	team, err := h.teamService.GetTeam(r.Context(), r.PathValue("name"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, mapping.MapDomainTeamToDTO(team))
+}
+
+// ListTeamMembers godoc
+// @Summary      Участники команды
+// @Description  Возвращает пользователей команды.
+// @Tags         v2 Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        name  path      string  true  "Имя команды"
+// @Success      200   {object}  dto.DataResponse{data=[]dto.UserDTO}
+// @Failure      401   {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404   {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/teams/{name}/members [get]
+func (h *Handler) ListTeamMembers(w http.ResponseWriter, r *http.Request) {
+	team, err := h.teamService.GetTeam(r.Context(), r.PathValue("name"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	members := make([]dto.UserDTO, 0, len(team.Members))
+	for _, m := range team.Members {
+		m.TeamName = team.Name
+		members = append(members, mapping.MapDomainUserToDTO(&m))
+	}
+
+	writeData(w, http.StatusOK, members)
+}
@@ -0,0 +1,115 @@
+package apiv2
+
+import (
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// GetUser godoc
+// @Summary      Получить пользователя
+// @Tags         v2 Users
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID пользователя"
+// @Success      200  {object}  dto.DataResponse{data=dto.UserDTO}
+// @Failure      401  {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404  {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/users/{id} [get]
+func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.userService.GetUser(r.Context(), r.PathValue("id"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, mapping.MapDomainUserToDTO(user))
+}
+
+// UpdateUser godoc
+// @Summary      Изменить пользователя
+// @Description  Частично обновляет пользователя; сейчас меняется только is_active.
+// @Tags         v2 Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string                 true  "ID пользователя"
+// @Param        body  body      dto.UpdateUserRequest  true  "Изменяемые поля"
+// @Success      200   {object}  dto.DataResponse{data=dto.UserDTO}
+// @Failure      400   {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse  "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/users/{id} [patch]
+func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateUserRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+	if req.IsActive == nil {
+		response.WriteError(w, validation.Missing("is_active"))
+		return
+	}
+
+	user, err := h.userService.SetIsActive(r.Context(), r.PathValue("id"), *req.IsActive)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, mapping.MapDomainUserToDTO(user))
+}
+
+// ListUserReviews godoc
+// @Summary      PR на ревью у пользователя
+// @Description  Возвращает PR, где пользователь назначен ревьювером.
+// @Tags         v2 Users
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID пользователя"
+// @Success      200  {object}  dto.DataResponse{data=[]dto.PullRequestShortDTO}
+// @Failure      401  {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404  {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /api/v2/users/{id}/reviews [get]
+func (h *Handler) ListUserReviews(w http.ResponseWriter, r *http.Request) {
+	prs, err := h.userService.GetReview(r.Context(), r.PathValue("id"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	result := make([]dto.PullRequestShortDTO, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, mapping.MapDomainPRToShortDTO(pr))
+	}
+
+	writeData(w, http.StatusOK, result)
+}
+
+// Me godoc
+// @Summary      Текущий пользователь
+// @Description  Пользователь, от имени которого выполняется запрос, и его права.
+// @Tags         v2 Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.DataResponse{data=dto.MeResponse}
+// @Failure      400  {object}  response.ErrorResponse  "VALIDATION (токен не привязан к пользователю)"
+// @Failure      401  {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Router       /api/v2/me [get]
+func (h *Handler) Me(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, err := h.userService.Me(ctx)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, mapping.MapIdentityToMeResponse(auth.FromContext(ctx), user))
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type AuditHandler struct {
+	auditService service.AuditService
+}
+
+func NewAuditHandler(auditService service.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// List godoc
+// @Summary      Журнал аудита
+// @Description  Возвращает записи об изменениях от новых к старым. Для следующей страницы передайте next_cursor в cursor. Доступно администраторам.
+// @Tags         Audit
+// @Produce      json
+// @Security     BearerAuth
+// @Param        actor        query     string  false  "Кто выполнил действие (user_id, token:<id>, system)"
+// @Param        action       query     string  false  "Действие, например pull_request.merge"
+// @Param        entity_type  query     string  false  "Тип сущности: team, user, pull_request, token, database"
+// @Param        entity_id    query     string  false  "Идентификатор сущности"
+// @Param        from         query     string  false  "Начало окна (RFC3339, включительно)"
+// @Param        to           query     string  false  "Конец окна (RFC3339, не включительно)"
+// @Param        limit        query     int     false  "Размер страницы, 1..500, по умолчанию 50"
+// @Param        cursor       query     string  false  "Курсор из next_cursor"
+// @Success      200  {object}  dto.AuditResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403  {object}  response.ErrorResponse   "FORBIDDEN"
+// @Router       /audit [get]
+func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	filter, err := request.ParseAuditFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	entries, err := h.auditService.List(ctx, filter)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = service.DefaultAuditLimit
+	}
+	resp := mapping.MapAuditToDTO(entries, limit)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
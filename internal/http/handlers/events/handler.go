@@ -0,0 +1,156 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+const (
+	// retryMillis — через сколько клиент переподключается после обрыва потока.
+	retryMillis = 3000
+	// writeTimeout — сколько ждать записи одной порции потока. Дедлайн
+	// продлевается перед каждой записью, поэтому http.write_timeout поток не обрывает.
+	writeTimeout = 10 * time.Second
+)
+
+type EventsHandler struct {
+	events service.ReviewEventService
+	cfg    config.EventsConfig
+}
+
+func NewEventsHandler(events service.ReviewEventService, cfg config.EventsConfig) *EventsHandler {
+	return &EventsHandler{events: events, cfg: cfg}
+}
+
+// Stream godoc
+// @Summary      Поток событий ревью
+// @Description  Server-Sent Events: pr.created, pr.assigned, pr.reassigned и pr.merged. Тип события — в поле event, данные — ReviewEventDTO в data.
+// @Description  При переподключении передайте id последнего события в заголовке Last-Event-ID (или в last_event_id) — поток продолжится с него. Без него поток начинается с текущего момента.
+// @Tags         Events
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        team_name      query     string  false  "Только PR авторов команды"
+// @Param        user_id        query     string  false  "Только PR, где пользователь автор или ревьювер"
+// @Param        last_event_id  query     string  false  "Id события, после которого продолжить поток, например 7391-42.1"
+// @Param        Last-Event-ID  header    string  false  "Id события, после которого продолжить поток, например 7391-42.1"
+// @Success      200  {array}   dto.ReviewEventDTO
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /events/stream [get]
+func (h *EventsHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	filter := request.ParseReviewEventFilter(r)
+
+	cursor, ok, err := request.ParseLastEventID(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	// Подписка до первого чтения: событие, записанное между чтением и
+	// ожиданием, всё равно разбудит поток.
+	wake, unsubscribe := h.events.Subscribe()
+	defer unsubscribe()
+
+	if !ok {
+		cursor, err = h.events.Latest(ctx)
+		if err != nil {
+			response.WriteError(w, err)
+			return
+		}
+	}
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := write(w, rc, fmt.Sprintf("retry: %d\n\n", retryMillis)); err != nil {
+		return
+	}
+
+	poll := time.NewTicker(h.cfg.PollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(h.cfg.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		events, next, err := h.events.Since(ctx, cursor, filter)
+		if err != nil {
+			// Клиент переподключится и продолжит с последнего полученного события.
+			if ctx.Err() == nil {
+				response.RecordError(w, err)
+			}
+			return
+		}
+
+		if len(events) > 0 {
+			if err := write(w, rc, formatEvents(events)); err != nil {
+				return
+			}
+			heartbeat.Reset(h.cfg.HeartbeatInterval)
+		}
+
+		if next != cursor {
+			// Пачка могла быть неполной — читаем дальше без ожидания.
+			cursor = next
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, open := <-wake:
+			if !open {
+				return
+			}
+		case <-poll.C:
+		case <-heartbeat.C:
+			if err := write(w, rc, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func formatEvents(events []domain.ReviewEvent) string {
+	var buf []byte
+	for _, ev := range events {
+		d := mapping.MapReviewEventToDTO(ev)
+		data, _ := json.Marshal(d)
+		buf = fmt.Appendf(buf, "id: %s\nevent: %s\ndata: %s\n\n", d.ID, d.Type, data)
+	}
+	return string(buf)
+}
+
+// write отправляет порцию потока клиенту, продлевая дедлайн записи.
+func write(w http.ResponseWriter, rc *http.ResponseController, chunk string) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := io.WriteString(w, chunk); err != nil {
+		return err
+	}
+	if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package export
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type ExportHandler struct {
+	exportService service.ExportService
+}
+
+func NewExportHandler(exportService service.ExportService) *ExportHandler {
+	return &ExportHandler{exportService: exportService}
+}
+
+// PullRequests godoc
+// @Summary      Выгрузка PR с ревьюверами
+// @Description  Потоково выгружает pull request'ы с назначенными ревьюверами в CSV или NDJSON. Формат выбирается параметром format или заголовком Accept.
+// @Tags         Export
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Security     BearerAuth
+// @Param        format     query     string  false  "csv или ndjson"
+// @Param        from       query     string  false  "Начало окна по created_at (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна по created_at (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только PR авторов команды"
+// @Param        status     query     string  false  "OPEN или MERGED"
+// @Success      200  {array}   dto.PullRequestDTO
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /export/pullRequests [get]
+func (h *ExportHandler) PullRequests(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	rw, filter, ok := h.prepare(w, r, "pull_requests", []string{
+		"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at",
+	})
+	if !ok {
+		return
+	}
+
+	err := h.exportService.ExportPullRequests(r.Context(), filter, func(pr domain.PullRequest) error {
+		return rw.Write([]string{
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			pr.PullRequestStatus,
+			strings.Join(pr.ReviewersID, ";"),
+			formatTime(&pr.CreatedAt),
+			formatTime(pr.MergedAt),
+		}, mapping.MapDomainPRToDTO(&pr))
+	})
+	finish(w, rw, err)
+}
+
+// Assignments godoc
+// @Summary      Выгрузка назначений ревьюверов
+// @Description  Потоково выгружает текущие назначения ревьюверов с временем назначения и ревью в CSV или NDJSON.
+// @Description  Это срез на момент выгрузки: ревьюверы, снятые переназначением, не попадают; их история — в GET /audit (action=pull_request.reassign).
+// @Tags         Export
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Security     BearerAuth
+// @Param        format     query     string  false  "csv или ndjson"
+// @Param        from       query     string  false  "Начало окна по assigned_at (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна по assigned_at (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только ревьюверы команды"
+// @Param        status     query     string  false  "OPEN или MERGED"
+// @Success      200  {array}   dto.AssignmentDTO
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /export/assignments [get]
+func (h *ExportHandler) Assignments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	rw, filter, ok := h.prepare(w, r, "assignments", []string{
+		"pull_request_id", "pull_request_name", "author_id", "status", "reviewer_id", "reviewer_team", "assigned_at", "reviewed_at",
+	})
+	if !ok {
+		return
+	}
+
+	err := h.exportService.ExportAssignments(r.Context(), filter, func(a domain.Assignment) error {
+		return rw.Write([]string{
+			a.PullRequestID,
+			a.PullRequestName,
+			a.AuthorID,
+			a.Status,
+			a.ReviewerID,
+			a.ReviewerTeam,
+			formatTime(&a.AssignedAt),
+			formatTime(a.ReviewedAt),
+		}, mapping.MapDomainAssignmentToDTO(a))
+	})
+	finish(w, rw, err)
+}
+
+// ReviewerStats godoc
+// @Summary      Выгрузка статистики по ревьюверам
+// @Description  Потоково выгружает статистику /stats/reviewers в CSV или NDJSON.
+// @Tags         Export
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Security     BearerAuth
+// @Param        format     query     string  false  "csv или ndjson"
+// @Param        from       query     string  false  "Начало окна по created_at PR (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна по created_at PR (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только участники команды"
+// @Param        status     query     string  false  "OPEN или MERGED"
+// @Success      200  {array}   dto.ReviewerStatsItem
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /export/reviewerStats [get]
+func (h *ExportHandler) ReviewerStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	rw, filter, ok := h.prepare(w, r, "reviewer_stats", []string{
+		"user_id", "username", "assigned_count", "open_count", "merged_count",
+	})
+	if !ok {
+		return
+	}
+
+	err := h.exportService.ExportReviewerStats(r.Context(), filter, func(s domain.ReviewerStats) error {
+		return rw.Write([]string{
+			s.UserID,
+			s.UserName,
+			strconv.FormatInt(s.AssignedCount, 10),
+			strconv.FormatInt(s.OpenCount, 10),
+			strconv.FormatInt(s.MergedCount, 10),
+		}, mapping.ReviewerStatsItemToDTO(s))
+	})
+	finish(w, rw, err)
+}
+
+func (h *ExportHandler) prepare(w http.ResponseWriter, r *http.Request, filename string, header []string) (*rowWriter, domain.StatsFilter, bool) {
+	format, err := negotiateFormat(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return nil, domain.StatsFilter{}, false
+	}
+
+	filter, err := request.ParseStatsFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return nil, domain.StatsFilter{}, false
+	}
+
+	return newRowWriter(w, format, filename, header), filter, true
+}
+
+// finish завершает выгрузку. Если данные уже начали уходить клиенту, вернуть
+// ErrorResponse нельзя — ответ просто обрывается, и клиент увидит незавершённый поток.
+func finish(w http.ResponseWriter, rw *rowWriter, err error) {
+	if err != nil {
+		if rw.Started() {
+			response.RecordError(w, err)
+		} else {
+			response.WriteError(w, err)
+		}
+		return
+	}
+
+	if err := rw.Close(); err != nil {
+		response.RecordError(w, err)
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
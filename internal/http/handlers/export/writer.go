@@ -0,0 +1,153 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// Каждые flushEvery строк данные отправляются клиенту, чтобы не держать их в буфере.
+	flushEvery = 1000
+)
+
+// negotiateFormat выбирает формат по параметру format, затем по заголовку Accept.
+// По умолчанию — NDJSON.
+func negotiateFormat(r *http.Request) (string, error) {
+	switch f := r.URL.Query().Get("format"); f {
+	case formatCSV, formatNDJSON:
+		return f, nil
+	case "":
+	default:
+		return "", validation.Invalid("format", validation.RuleOneOf, "format must be csv or ndjson")
+	}
+
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case contentTypeCSV:
+			return formatCSV, nil
+		case contentTypeNDJSON, "application/jsonl", "application/json":
+			return formatNDJSON, nil
+		}
+	}
+
+	return formatNDJSON, nil
+}
+
+// rowWriter пишет строки выгрузки в выбранном формате. Заголовки ответа
+// отправляются при первой строке, поэтому ошибку до неё ещё можно вернуть обычным ErrorResponse.
+type rowWriter struct {
+	w        http.ResponseWriter
+	rc       *http.ResponseController
+	format   string
+	filename string
+	header   []string
+
+	csv     *csv.Writer
+	json    *json.Encoder
+	started bool
+	rows    int
+}
+
+func newRowWriter(w http.ResponseWriter, format, filename string, header []string) *rowWriter {
+	return &rowWriter{
+		w:        w,
+		rc:       http.NewResponseController(w),
+		format:   format,
+		filename: filename,
+		header:   header,
+	}
+}
+
+func (rw *rowWriter) start() error {
+	rw.started = true
+
+	contentType, ext := contentTypeNDJSON, formatNDJSON
+	if rw.format == formatCSV {
+		contentType, ext = contentTypeCSV, formatCSV
+	}
+
+	rw.w.Header().Set("Content-Type", contentType)
+	rw.w.Header().Set("Content-Disposition", `attachment; filename="`+rw.filename+"."+ext+`"`)
+	rw.w.WriteHeader(http.StatusOK)
+
+	if rw.format == formatCSV {
+		rw.csv = csv.NewWriter(rw.w)
+		return rw.csv.Write(rw.header)
+	}
+
+	rw.json = json.NewEncoder(rw.w)
+	return nil
+}
+
+// Write пишет одну строку: record для CSV, v — для NDJSON.
+func (rw *rowWriter) Write(record []string, v any) error {
+	if !rw.started {
+		if err := rw.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if rw.format == formatCSV {
+		err = rw.csv.Write(record)
+	} else {
+		err = rw.json.Encode(v)
+	}
+	if err != nil {
+		return err
+	}
+
+	rw.rows++
+	if rw.rows%flushEvery == 0 {
+		return rw.flush()
+	}
+
+	return nil
+}
+
+// Close дописывает заголовки для пустой выгрузки и сбрасывает буфер.
+func (rw *rowWriter) Close() error {
+	if !rw.started {
+		if err := rw.start(); err != nil {
+			return err
+		}
+	}
+
+	return rw.flush()
+}
+
+func (rw *rowWriter) flush() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		if err := rw.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := rw.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	return nil
+}
+
+// Started сообщает, ушли ли клиенту заголовки ответа.
+func (rw *rowWriter) Started() bool {
+	return rw.started
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/buildinfo"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/health"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+type HealthHandler struct {
+	probe *health.Probe
+}
+
+func NewHealthHandler(probe *health.Probe) *HealthHandler {
+	return &HealthHandler{probe: probe}
+}
+
+// Healthz godoc
+// @Summary      Liveness-проба
+// @Description  Отвечает 200, пока процесс жив. Зависимости не проверяются.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  dto.HealthResponse
+// @Router       /healthz [get]
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	if !allowProbeMethod(w, r) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.HealthResponse{Status: "ok"})
+}
+
+// Readyz godoc
+// @Summary      Readiness-проба
+// @Description  Проверяет доступность БД, актуальность миграций и работу фоновых задач. Во время остановки сервиса всегда отвечает 503.
+// @Description  Отдаёт только имя и статус проверок; текст ошибок пишется в лог сервиса.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  dto.ReadinessResponse
+// @Failure      503  {object}  dto.ReadinessResponse
+// @Router       /readyz [get]
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	if !allowProbeMethod(w, r) {
+		return
+	}
+
+	report := h.probe.Ready(r.Context())
+
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	// Текст ошибок проверок попадает только в access-лог: ответ видят без токена.
+	var errs []error
+	for _, c := range report.Checks {
+		if c.Error != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, c.Error))
+		}
+	}
+	if len(errs) > 0 {
+		response.RecordError(w, errors.Join(errs...))
+	}
+
+	writeJSON(w, status, mapping.MapReadinessReportToDTO(report))
+}
+
+// Version godoc
+// @Summary      Информация о сборке
+// @Description  Возвращает версию, коммит и время сборки сервиса.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  dto.VersionResponse
+// @Router       /version [get]
+func (h *HealthHandler) Version(w http.ResponseWriter, r *http.Request) {
+	if !allowProbeMethod(w, r) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapping.MapBuildInfoToDTO(buildinfo.Get()))
+}
+
+func allowProbeMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,91 @@
+package imports
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/importer"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+type ImportHandler struct {
+	importService service.ImportService
+}
+
+func NewImportHandler(importService service.ImportService) *ImportHandler {
+	return &ImportHandler{importService: importService}
+}
+
+// Import godoc
+// @Summary      Массовый импорт команд, участников и истории PR
+// @Description  Принимает документ в JSON, YAML или CSV (по параметру format или Content-Type). Документ целиком проверяется до загрузки; при ошибках возвращается отчёт по строкам и ничего не записывается. Загрузка выполняется одной транзакцией, при dry_run=true транзакция откатывается.
+// @Tags         Import
+// @Accept       json
+// @Accept       application/yaml
+// @Accept       text/csv
+// @Produce      json
+// @Security     BearerAuth
+// @Param        format   query     string  false  "json, yaml или csv"
+// @Param        dry_run  query     bool    false  "Только проверить документ"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200   {object}  dto.ImportResponse                 "dry run"
+// @Success      201   {object}  dto.ImportResponse                 "applied"
+// @Failure      400   {object}  dto.ImportResponse                 "ошибки строк документа"
+// @Failure      401   {object}  response.ErrorResponse             "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse             "FORBIDDEN"
+// @Failure      409   {object}  response.ErrorResponse             "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      413   {object}  response.ErrorResponse             "PAYLOAD_TOO_LARGE"
+// @Failure      500   {object}  response.ErrorResponse             "INTERNAL"
+// @Router       /import [post]
+func (h *ImportHandler) Import(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = importer.FormatFromContentType(r.Header.Get("Content-Type"))
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			response.WriteError(w, validation.Invalid("dry_run", validation.RuleType, "dry_run must be boolean"))
+			return
+		}
+	}
+
+	batch, err := importer.Parse(r.Body, format)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	result, err := h.importService.Import(ctx, batch, dryRun)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case len(result.Errors) > 0:
+		status = http.StatusBadRequest
+	case result.DryRun:
+		status = http.StatusOK
+	}
+
+	resp := mapping.MapImportResultToDTO(result)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
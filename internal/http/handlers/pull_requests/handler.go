@@ -4,9 +4,9 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
 )
@@ -25,10 +25,15 @@ func NewPullRequestHandler(prService service.PullRequestService) *PullRequestHan
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        body  body      dto.CreatePullRequestRequest  true  "Pull request create body"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
 // @Success      201   {object}  dto.CreatePullRequestResponse
+// @Header       201   {string}  ETag  "Версия PR"
 // @Failure      400   {object}  response.ErrorResponse             "VALIDATION / NOT_FOUND (author/team)"
-// @Failure      409   {object}  response.ErrorResponse             "PR_EXISTS"
+// @Failure      401   {object}  response.ErrorResponse             "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse             "FORBIDDEN"
+// @Failure      409   {object}  response.ErrorResponse             "PR_EXISTS / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
 // @Router       /pullRequest/create [post]
 func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -39,11 +44,12 @@ func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var req dto.CreatePullRequestRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "invalid json body"))
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
 	}
 
-	pr, err := h.prService.CreatePullRequest(ctx, req.PullRequestID, req.PullRequestID, req.AuthorID)
+	pr, err := h.prService.CreatePullRequest(ctx, req.PullRequestID, req.PullRequestName, req.AuthorID)
 	if err != nil {
 		response.WriteError(w, err)
 		return
@@ -53,21 +59,67 @@ func (h *PullRequestHandler) Create(w http.ResponseWriter, r *http.Request) {
 		PR: mapping.MapDomainPRToDTO(pr),
 	}
 
+	w.Header().Set("ETag", request.ETag(pr.Version))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// Get godoc
+// @Summary      Получить PR
+// @Description  Возвращает pull request с ревьюверами и его версию в ETag — для последующего изменения с If-Match.
+// @Tags         PullRequests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        pull_request_id  query     string  true  "Pull request id"
+// @Success      200              {object}  dto.GetPullRequestResponse
+// @Header       200              {string}  ETag  "Версия PR"
+// @Failure      400              {object}  response.ErrorResponse  "VALIDATION"
+// @Failure      401              {object}  response.ErrorResponse  "UNAUTHORIZED"
+// @Failure      404              {object}  response.ErrorResponse  "NOT_FOUND"
+// @Router       /pullRequest/get [get]
+func (h *PullRequestHandler) Get(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	pr, err := h.prService.GetPullRequest(ctx, r.URL.Query().Get("pull_request_id"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.GetPullRequestResponse{
+		PR: mapping.MapDomainPRToDTO(pr),
+	}
+
+	w.Header().Set("ETag", request.ETag(pr.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 // Merge godoc
 // @Summary      Пометить PR как MERGED (идемпотентная операция)
 // @Description  Переводит pull request в состояние MERGED. Повторный вызов не приводит к ошибке.
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        body  body      dto.MergePullRequestRequest  true  "Pull request id"
+// @Param        If-Match  header    string  false  "ETag PR из предыдущего ответа; запрос выполнится, только если PR не менялся"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
 // @Success      200   {object}  dto.MergePullRequestResponse
+// @Header       200   {string}  ETag  "Версия PR"
 // @Failure      400   {object}  response.ErrorResponse            "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse            "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse            "FORBIDDEN"
 // @Failure      404   {object}  response.ErrorResponse            "NOT_FOUND"
+// @Failure      409   {object}  response.ErrorResponse            "CONCURRENT_UPDATE / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      412   {object}  response.ErrorResponse            "CONFLICT (PR изменился)"
 // @Router       /pullRequest/merge [post]
 func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -77,12 +129,19 @@ func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
 	var req dto.MergePullRequestRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "invalid json body"))
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
 	}
 
-	mergedPR, err := h.prService.MergePullRequest(ctx, req.PullRequestID)
+	mergedPR, err := h.prService.MergePullRequest(ctx, req.PullRequestID, expectedVersion)
 	if err != nil {
 		response.WriteError(w, err)
 		return
@@ -92,6 +151,7 @@ func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 		PR: mapping.MapDomainPRToDTO(mergedPR),
 	}
 
+	w.Header().Set("ETag", request.ETag(mergedPR.Version))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
@@ -103,11 +163,18 @@ func (h *PullRequestHandler) Merge(w http.ResponseWriter, r *http.Request) {
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        body  body      dto.ReassignPullRequestRequest  true  "Reassign body"
+// @Param        If-Match  header    string  false  "ETag PR из предыдущего ответа; запрос выполнится, только если PR не менялся"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
 // @Success      200   {object}  dto.ReassignPullRequestResponse
+// @Header       200   {string}  ETag  "Версия PR"
 // @Failure      400   {object}  response.ErrorResponse               "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse               "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse               "FORBIDDEN"
 // @Failure      404   {object}  response.ErrorResponse               "NOT_FOUND"
-// @Failure      409   {object}  response.ErrorResponse               "PR_MERGED / NOT_ASSIGNED / NO_CANDIDATE"
+// @Failure      409   {object}  response.ErrorResponse               "PR_MERGED / NOT_ASSIGNED / NO_CANDIDATE / CONCURRENT_UPDATE / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      412   {object}  response.ErrorResponse               "CONFLICT (PR изменился с версии из If-Match)"
 // @Router       /pullRequest/reassign [post]
 func (h *PullRequestHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -117,12 +184,19 @@ func (h *PullRequestHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
 	var req dto.ReassignPullRequestRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "invalid json body"))
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
 	}
 
-	reAssignedPR, replacedBy, err := h.prService.ReAssignPullRequest(ctx, req.PullRequestID, req.OldUserID)
+	reAssignedPR, replacedBy, err := h.prService.ReAssignPullRequest(ctx, req.PullRequestID, req.OldUserID, expectedVersion)
 	if err != nil {
 		response.WriteError(w, err)
 		return
@@ -133,6 +207,62 @@ func (h *PullRequestHandler) ReAssign(w http.ResponseWriter, r *http.Request) {
 		ReplacedBy: replacedBy,
 	}
 
+	w.Header().Set("ETag", request.ETag(reAssignedPR.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Review godoc
+// @Summary      Отметить, что ревьювер провёл ревью PR
+// @Description  Фиксирует время первого ревью назначенного ревьювера. Повторный вызов не меняет время.
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.ReviewPullRequestRequest  true  "Pull request id and reviewer id"
+// @Param        If-Match  header    string  false  "ETag PR из предыдущего ответа; запрос выполнится, только если PR не менялся"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200   {object}  dto.ReviewPullRequestResponse
+// @Header       200   {string}  ETag  "Версия PR"
+// @Failure      400   {object}  response.ErrorResponse             "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse             "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse             "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse             "NOT_FOUND"
+// @Failure      409   {object}  response.ErrorResponse             "NOT_ASSIGNED / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Failure      412   {object}  response.ErrorResponse             "CONFLICT (PR изменился)"
+// @Router       /pullRequest/review [post]
+func (h *PullRequestHandler) Review(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	expectedVersion, err := request.ParseIfMatch(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	var req dto.ReviewPullRequestRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	reviewedPR, err := h.prService.MarkReviewed(ctx, req.PullRequestID, req.ReviewerID, expectedVersion)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.ReviewPullRequestResponse{
+		PR: mapping.MapDomainPRToDTO(reviewedPR),
+	}
+
+	w.Header().Set("ETag", request.ETag(reviewedPR.Version))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
@@ -0,0 +1,159 @@
+package roles
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type RoleHandler struct {
+	roleService service.RoleService
+}
+
+func NewRoleHandler(roleService service.RoleService) *RoleHandler {
+	return &RoleHandler{roleService: roleService}
+}
+
+// List godoc
+// @Summary      Список ролей
+// @Description  Возвращает роли и их права. Доступно администраторам.
+// @Tags         Roles
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListRolesResponse
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403  {object}  response.ErrorResponse   "FORBIDDEN"
+// @Router       /roles/list [get]
+func (h *RoleHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	roles, err := h.roleService.ListRoles(r.Context())
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.MapRolesToDTO(roles)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Assignments godoc
+// @Summary      Назначенные роли
+// @Description  Возвращает назначения ролей, всех или одного пользователя. Роли по умолчанию не выводятся. Доступно администраторам.
+// @Tags         Roles
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id  query     string  false  "Пользователь"
+// @Success      200      {object}  dto.ListRoleAssignmentsResponse
+// @Failure      401      {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403      {object}  response.ErrorResponse   "FORBIDDEN"
+// @Router       /roles/assignments [get]
+func (h *RoleHandler) Assignments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	assignments, err := h.roleService.ListAssignments(r.Context(), r.URL.Query().Get("user_id"))
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.MapRoleAssignmentsToDTO(assignments)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Assign godoc
+// @Summary      Назначить роль
+// @Description  Назначает пользователю роль глобально или в команде team_name (для team_lead команда обязательна). Повторное назначение не считается ошибкой. Доступно администраторам.
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.RoleAssignmentRequest   true  "Пользователь, роль и команда"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200   {object}  dto.RoleAssignmentResponse
+// @Failure      400   {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse   "NOT_FOUND (пользователь, роль или команда)"
+// @Failure      409   {object}  response.ErrorResponse   "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /roles/assign [post]
+func (h *RoleHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	var req dto.RoleAssignmentRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	assignment, err := h.roleService.Assign(ctx, req.UserID, req.Role, req.TeamName)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.RoleAssignmentResponse{Assignment: mapping.MapRoleAssignmentToDTO(assignment)}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Unassign godoc
+// @Summary      Снять роль
+// @Description  Удаляет назначение роли. Доступно администраторам.
+// @Tags         Roles
+// @Accept       json
+// @Security     BearerAuth
+// @Param        body  body      dto.RoleAssignmentRequest   true  "Пользователь, роль и команда"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      204
+// @Failure      400   {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse   "NOT_FOUND"
+// @Failure      409   {object}  response.ErrorResponse   "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /roles/unassign [post]
+func (h *RoleHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	var req dto.RoleAssignmentRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	if err := h.roleService.Unassign(ctx, req.UserID, req.Role, req.TeamName); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
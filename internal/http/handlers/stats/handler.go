@@ -6,6 +6,7 @@ import (
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
 )
@@ -20,16 +21,29 @@ func NewStatsHandler(statsService service.StatsService) *StatsHandler {
 
 // GetReviewerStats godoc
 // @Summary      Статистика по ревьюверам
-// @Description  Возвращает количество назначений на ревью для каждого пользователя
+// @Description  Возвращает количество назначений на ревью для каждого пользователя (всего, в открытых и в смёрженных PR).
 // @Tags         Stats
 // @Produce      json
+// @Security     BearerAuth
+// @Param        from       query     string  false  "Начало окна по created_at PR (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна по created_at PR (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только участники команды"
+// @Param        status     query     string  false  "Статус PR: OPEN или MERGED"
 // @Success      200  {object}  dto.ReviewerStatsResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
 // @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
 // @Router       /stats/reviewers [get]
 func (h *StatsHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	stats, err := h.statsService.GetReviewerStats(ctx)
+	filter, err := request.ParseStatsFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	stats, err := h.statsService.GetReviewerStats(ctx, filter)
 	if err != nil {
 		response.WriteError(w, apperror.From(err))
 		return
@@ -40,3 +54,109 @@ func (h *StatsHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+// GetTeamStats godoc
+// @Summary      Статистика по командам
+// @Description  Возвращает по каждой команде количество назначений на ревью, смёрженных PR авторов команды и число участников.
+// @Tags         Stats
+// @Produce      json
+// @Security     BearerAuth
+// @Param        from       query     string  false  "Начало окна (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только указанная команда"
+// @Param        status     query     string  false  "Статус PR для подсчёта назначений: OPEN или MERGED"
+// @Success      200  {object}  dto.TeamStatsResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
+// @Router       /stats/teams [get]
+func (h *StatsHandler) GetTeamStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := request.ParseStatsFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	stats, err := h.statsService.GetTeamStats(ctx, filter)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.TeamStatsToDTO(stats)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GetLatency godoc
+// @Summary      Метрики времени ревью
+// @Description  Возвращает перцентили p50/p90/p99 (в секундах) времени до мержа по командам, авторам и неделям, а также времени до первого ревью по ревьюверам.
+// @Tags         Stats
+// @Produce      json
+// @Security     BearerAuth
+// @Param        from       query     string  false  "Начало окна по merged_at / reviewed_at (RFC3339, включительно)"
+// @Param        to         query     string  false  "Конец окна по merged_at / reviewed_at (RFC3339, не включительно)"
+// @Param        team_name  query     string  false  "Только указанная команда"
+// @Success      200  {object}  dto.LatencyStatsResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
+// @Router       /stats/latency [get]
+func (h *StatsHandler) GetLatency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := request.ParseStatsFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	report, err := h.statsService.GetLatency(ctx, filter)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.LatencyReportToDTO(report)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GetFairness godoc
+// @Summary      Равномерность распределения ревью
+// @Description  Для каждой команды сравнивает долю назначений участника с ожидаемой (пропорциональной времени активности в окне), считает коэффициент Джини и отмечает перегруженных/недогруженных.
+// @Tags         Stats
+// @Produce      json
+// @Security     BearerAuth
+// @Param        from       query     string  false  "Начало окна (RFC3339, по умолчанию to минус 30 дней)"
+// @Param        to         query     string  false  "Конец окна (RFC3339, по умолчанию текущее время)"
+// @Param        team_name  query     string  false  "Только указанная команда"
+// @Success      200  {object}  dto.FairnessStatsResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      500  {object}  response.ErrorResponse   "INTERNAL"
+// @Router       /stats/fairness [get]
+func (h *StatsHandler) GetFairness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := request.ParseStatsFilter(r)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	teams, err := h.statsService.GetFairness(ctx, filter)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.FairnessToDTO(teams)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -4,11 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
 )
 
 type TeamHandler struct {
@@ -25,10 +26,14 @@ func NewTeamHandler(teamService service.TeamService) *TeamHandler {
 // @Tags         Teams
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        body  body      dto.TeamDTO           true  "Team body"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
 // @Success      201   {object}  dto.TeamAddResponse
 // @Failure      400   {object}  response.ErrorResponse     "VALIDATION"
-// @Failure      409   {object}  response.ErrorResponse     "TEAM_EXISTS"
+// @Failure      401   {object}  response.ErrorResponse     "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse     "FORBIDDEN"
+// @Failure      409   {object}  response.ErrorResponse     "TEAM_EXISTS / IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
 // @Router       /team/add [post]
 func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -39,8 +44,8 @@ func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var req dto.TeamDTO
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "invalid JSON body"))
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
 		return
 	}
 
@@ -66,9 +71,11 @@ func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 // @Description  Возвращает команду и список её участников по имени команды.
 // @Tags         Teams
 // @Produce      json
+// @Security     BearerAuth
 // @Param        team_name  query     string        true  "Team name"
 // @Success      200        {object}  dto.TeamDTO
 // @Failure      400        {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401        {object}  response.ErrorResponse   "UNAUTHORIZED"
 // @Failure      404        {object}  response.ErrorResponse   "NOT_FOUND"
 // @Router       /team/get [get]
 func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
@@ -81,13 +88,14 @@ func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	teamName := r.URL.Query().Get("team_name")
 	if teamName == "" {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "team_name is required"))
+		response.WriteError(w, validation.Missing("team_name"))
 		return
 	}
 
 	team, err := h.teamService.GetTeam(ctx, teamName)
 	if err != nil {
 		response.WriteError(w, err)
+		return
 	}
 
 	resp := mapping.MapDomainTeamToDTO(team)
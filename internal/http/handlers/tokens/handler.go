@@ -0,0 +1,139 @@
+package tokens
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
+)
+
+type TokenHandler struct {
+	tokenService service.TokenService
+}
+
+func NewTokenHandler(tokenService service.TokenService) *TokenHandler {
+	return &TokenHandler{tokenService: tokenService}
+}
+
+// Create godoc
+// @Summary      Выпустить API-токен
+// @Description  Создаёт токен для заголовка Authorization: Bearer. Значение токена возвращается только в этом ответе. Доступно администраторам.
+// @Tags         Tokens
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.CreateTokenRequest   true  "Имя, владелец и срок действия токена"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      201   {object}  dto.CreateTokenResponse
+// @Failure      400   {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse   "NOT_FOUND (пользователь)"
+// @Failure      409   {object}  response.ErrorResponse   "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /tokens/create [post]
+func (h *TokenHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	var req dto.CreateTokenRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	token, raw, err := h.tokenService.Create(ctx, req.Name, req.UserID, req.IsAdmin, req.ExpiresAt)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.CreateTokenResponse{
+		Token:     raw,
+		TokenInfo: mapping.MapTokenToDTO(token),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// List godoc
+// @Summary      Список API-токенов
+// @Description  Возвращает все токены без их значений. Доступно администраторам.
+// @Tags         Tokens
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListTokensResponse
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403  {object}  response.ErrorResponse   "FORBIDDEN"
+// @Router       /tokens/list [get]
+func (h *TokenHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	tokens, err := h.tokenService.List(r.Context())
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.MapTokensToDTO(tokens)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Revoke godoc
+// @Summary      Отозвать API-токен
+// @Description  Помечает токен отозванным; повторный отзыв не меняет дату. Доступно администраторам.
+// @Tags         Tokens
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.RevokeTokenRequest   true  "Идентификатор токена"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
+// @Success      200   {object}  dto.RevokeTokenResponse
+// @Failure      400   {object}  response.ErrorResponse   "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse   "FORBIDDEN"
+// @Failure      404   {object}  response.ErrorResponse   "NOT_FOUND"
+// @Failure      409   {object}  response.ErrorResponse   "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
+// @Router       /tokens/revoke [post]
+func (h *TokenHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	var req dto.RevokeTokenRequest
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	token, err := h.tokenService.Revoke(ctx, req.TokenID)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.RevokeTokenResponse{TokenInfo: mapping.MapTokenToDTO(token)}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -4,9 +4,10 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/dto/mapping"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/request"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/service"
 )
@@ -25,10 +26,15 @@ func NewUsersHandler(userService service.UserService) *UsersHandler {
 // @Tags         Users
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        body  body      dto.SetIsActiveRequest   true  "User id and new active flag"
+// @Param        Idempotency-Key  header    string  false  "Ключ идемпотентности: повтор с тем же ключом вернёт сохранённый ответ"
 // @Success      200   {object}  dto.SetIsActiveResponse
 // @Failure      400   {object}  response.ErrorResponse        "VALIDATION"
+// @Failure      401   {object}  response.ErrorResponse        "UNAUTHORIZED"
+// @Failure      403   {object}  response.ErrorResponse        "FORBIDDEN"
 // @Failure      404   {object}  response.ErrorResponse        "NOT_FOUND"
+// @Failure      409   {object}  response.ErrorResponse        "IDEMPOTENCY_KEY_REUSED / IDEMPOTENCY_IN_PROGRESS"
 // @Router       /users/setIsActive [post]
 func (h *UsersHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -39,8 +45,8 @@ func (h *UsersHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var req dto.SetIsActiveRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.WriteError(w, apperror.New(apperror.CodeValidation, "invalid json body"))
+	if err := request.DecodeJSON(r, &req); err != nil {
+		response.WriteError(w, err)
 		return
 	}
 
@@ -64,9 +70,11 @@ func (h *UsersHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 // @Description  Возвращает список pull request'ов, где пользователь указан как ревьювер.
 // @Tags         Users
 // @Produce      json
+// @Security     BearerAuth
 // @Param        user_id  query     string                    true  "User ID"
 // @Success      200      {object}  dto.GetReviewResponse
 // @Failure      400      {object}  response.ErrorResponse         "VALIDATION"
+// @Failure      401      {object}  response.ErrorResponse         "UNAUTHORIZED"
 // @Failure      404      {object}  response.ErrorResponse         "NOT_FOUND"
 // @Router       /users/getReview [get]
 func (h *UsersHandler) GetReview(w http.ResponseWriter, r *http.Request) {
@@ -98,3 +106,78 @@ func (h *UsersHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+// Me godoc
+// @Summary      Текущий пользователь
+// @Description  Возвращает пользователя, от имени которого выполняется запрос (API-токен с user_id или OIDC-токен), и его права.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.MeResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION (токен не привязан к пользователю)"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /me [get]
+func (h *UsersHandler) Me(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	user, err := h.userService.Me(ctx)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := mapping.MapIdentityToMeResponse(auth.FromContext(ctx), user)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// MyReviews godoc
+// @Summary      Мои ревью
+// @Description  Возвращает PR, где текущий пользователь назначен ревьювером.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.GetReviewResponse
+// @Failure      400  {object}  response.ErrorResponse   "VALIDATION (токен не привязан к пользователю)"
+// @Failure      401  {object}  response.ErrorResponse   "UNAUTHORIZED"
+// @Router       /me/reviews [get]
+func (h *UsersHandler) MyReviews(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	user, err := h.userService.Me(ctx)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	prs, err := h.userService.GetReview(ctx, user.ID)
+	if err != nil {
+		response.WriteError(w, err)
+		return
+	}
+
+	resp := dto.GetReviewResponse{
+		UserID:       user.ID,
+		PullRequests: make([]dto.PullRequestShortDTO, 0, len(prs)),
+	}
+
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, mapping.MapDomainPRToShortDTO(pr))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type routeKey struct{}
+
+// SetRoute сообщает AccessLog и Tracing зарегистрированный паттерн маршрута.
+// Роутер вызывает её уже после ServeMux, поэтому паттерн передаётся через
+// изменяемое значение в контексте, а не через новый *http.Request.
+func SetRoute(r *http.Request, route string) {
+	ctx := r.Context()
+	if p, ok := ctx.Value(routeKey{}).(*string); ok {
+		*p = route
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetName(r.Method + " " + route)
+	span.SetAttributes(semconv.HTTPRoute(route))
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+	err    error
+}
+
+func (r *responseRecorder) RecordError(err error) {
+	r.err = err
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += int64(n)
+	return n, err
+}
+
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// AccessLog пишет по одной записи на запрос: маршрут, статус, размер ответа,
+// длительность и ошибку обработчика. request_id добавляет логгер из контекста.
+func AccessLog(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			route := ""
+			rec := &responseRecorder{ResponseWriter: w}
+
+			ctx := context.WithValue(r.Context(), routeKey{}, &route)
+
+			defer func() {
+				status := rec.status
+				if status == 0 {
+					status = http.StatusOK
+				}
+
+				level := slog.LevelInfo
+				if status >= http.StatusInternalServerError {
+					level = slog.LevelError
+				}
+
+				attrs := []slog.Attr{
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.String("route", route),
+					slog.Int("status", status),
+					slog.Int64("bytes", rec.bytes),
+					slog.Duration("latency", time.Since(start)),
+					slog.String("remote_addr", r.RemoteAddr),
+				}
+				if rec.err != nil {
+					// Для клиента внутренняя ошибка скрыта за «internal error», в логе — полный текст.
+					attrs = append(attrs, slog.String("error", rec.err.Error()))
+				}
+
+				logger.LogAttrs(ctx, level, "http request", attrs...)
+			}()
+
+			next.ServeHTTP(rec, r.WithContext(ctx))
+		})
+	}
+}
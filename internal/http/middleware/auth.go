@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+// Authenticate требует заголовок Authorization: Bearer <token> и кладёт
+// идентичность вызывающего в контекст. Без валидного токена отвечает 401 UNAUTHORIZED.
+func Authenticate(authenticator auth.Authenticator) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="pr-reviewer-service"`)
+				response.WriteError(w, apperror.New(apperror.CodeUnauthorized, "bearer token required"))
+				return
+			}
+
+			identity, err := authenticator.Authenticate(r.Context(), token)
+			if err != nil {
+				if appErr := apperror.From(err); appErr != nil && appErr.Code == apperror.CodeUnauthorized {
+					w.Header().Set("WWW-Authenticate", `Bearer realm="pr-reviewer-service", error="invalid_token"`)
+				}
+				response.WriteError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), identity)))
+		})
+	}
+}
+
+// AssumeIdentity выполняет запрос от имени id без проверки токена. Нужен при
+// auth.enabled = false, чтобы сервисы получили явную идентичность.
+func AssumeIdentity(id *auth.Identity) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), id)))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(h, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
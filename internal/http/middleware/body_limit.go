@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+// BodyLimit ограничивает размер тела запроса. Запросы с заведомо большим
+// Content-Length отклоняются сразу, остальные обрываются при чтении. limit <= 0 — без ограничения.
+func BodyLimit(limit int64) Middleware {
+	return func(next http.Handler) http.Handler {
+		if limit <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				response.WriteError(w, apperror.New(apperror.CodePayloadTooLarge,
+					fmt.Sprintf("request body exceeds %d bytes", limit)))
+				return
+			}
+
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader отмечает ответ, повторённый из сохранённого.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// Тело запроса до spoolMemoryLimit держится в памяти, крупнее (загрузки
+	// /import и /admin/restore) — во временном файле.
+	spoolMemoryLimit = 1 << 20
+	// Ответы крупнее maxStoredResponse не сохраняются: ключ освобождается,
+	// и повтор выполнит запрос заново.
+	maxStoredResponse = 1 << 20
+)
+
+// storedHeaders — заголовки ответа, которые сохраняются и повторяются вместе с телом.
+var storedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// IdempotencyStore хранит ответы запросов с ключом идемпотентности.
+type IdempotencyStore interface {
+	Begin(ctx context.Context, key string, requestHash []byte) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency обрабатывает заголовок Idempotency-Key у POST-запросов. Первый
+// запрос с ключом выполняется, и его ответ сохраняется; повтор с тем же телом
+// получает сохранённый ответ без повторного выполнения, повтор с другим телом —
+// 409 IDEMPOTENCY_KEY_REUSED. Ответы 5xx не сохраняются, чтобы запрос можно было повторить.
+func Idempotency(store IdempotencyStore, logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keys := r.Header.Values(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || len(keys) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(keys) > 1 {
+				response.WriteError(w, validation.Invalid("Idempotency-Key", validation.RuleFormat, "only one Idempotency-Key header is allowed"))
+				return
+			}
+			key := keys[0]
+			ctx := r.Context()
+
+			body, hash, err := spoolBody(r)
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if !errors.As(err, &maxBytesErr) {
+					err = apperror.Wrap(apperror.CodeValidation, "read request body", err)
+				}
+				response.WriteError(w, err)
+				return
+			}
+			defer body.Close()
+
+			stored, err := store.Begin(ctx, key, hash)
+			if err != nil {
+				response.WriteError(w, err)
+				return
+			}
+			if stored != nil {
+				replay(w, stored)
+				return
+			}
+
+			// Клиент мог не дождаться ответа — ради этого он и повторяет запрос,
+			// поэтому результат сохраняется и после отмены контекста запроса.
+			storeCtx := context.WithoutCancel(ctx)
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.Release(storeCtx, key); err != nil {
+					logger.ErrorContext(ctx, "release idempotency key", slog.String("idempotency_key", key), slog.Any("error", err))
+				}
+			}()
+
+			capture := &captureWriter{ResponseWriter: w}
+			r.Body = body
+			next.ServeHTTP(capture, r)
+
+			status := capture.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				return
+			}
+			if capture.overflow {
+				logger.WarnContext(ctx, "response too large to store for idempotency key", slog.String("idempotency_key", key))
+				return
+			}
+
+			headers := make(map[string]string, len(storedHeaders))
+			for _, name := range storedHeaders {
+				if v := capture.Header().Get(name); v != "" {
+					headers[name] = v
+				}
+			}
+
+			if err := store.Complete(storeCtx, key, status, headers, capture.body.Bytes()); err != nil {
+				logger.ErrorContext(ctx, "store idempotent response", slog.String("idempotency_key", key), slog.Any("error", err))
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+func replay(w http.ResponseWriter, rec *domain.IdempotencyRecord) {
+	for name, value := range rec.Headers {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(rec.StatusCode)
+	_, _ = w.Write(rec.Body)
+}
+
+// spoolBody читает тело запроса целиком и считает хэш метода, пути с query,
+// Content-Type и тела. Возвращённое тело нужно закрыть: оно может лежать во временном файле.
+func spoolBody(r *http.Request) (io.ReadCloser, []byte, error) {
+	h := sha256.New()
+	_, _ = io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n"+r.Header.Get("Content-Type")+"\n")
+
+	var buf bytes.Buffer
+	n, err := io.Copy(io.MultiWriter(h, &buf), io.LimitReader(r.Body, spoolMemoryLimit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if n <= spoolMemoryLimit {
+		return io.NopCloser(&buf), h.Sum(nil), nil
+	}
+
+	f, err := os.CreateTemp("", "request-body-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	spooled := &tempFile{File: f}
+	if _, err := buf.WriteTo(f); err != nil {
+		_ = spooled.Close()
+		return nil, nil, err
+	}
+	if _, err := io.Copy(io.MultiWriter(h, f), r.Body); err != nil {
+		_ = spooled.Close()
+		return nil, nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		_ = spooled.Close()
+		return nil, nil, err
+	}
+
+	return spooled, h.Sum(nil), nil
+}
+
+// tempFile удаляет файл при закрытии.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	_ = os.Remove(f.Name())
+	return err
+}
+
+// captureWriter пишет ответ клиенту и копию — для сохранения, пока она не больше maxStoredResponse.
+type captureWriter struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (c *captureWriter) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *captureWriter) Write(b []byte) (int, error) {
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	if !c.overflow {
+		if c.body.Len()+len(b) > maxStoredResponse {
+			c.overflow = true
+			c.body = bytes.Buffer{}
+		} else {
+			c.body.Write(b)
+		}
+	}
+	return c.ResponseWriter.Write(b)
+}
+
+func (c *captureWriter) Unwrap() http.ResponseWriter {
+	return c.ResponseWriter
+}
@@ -0,0 +1,14 @@
+// Package middleware содержит обёртки http.Handler, общие для всех маршрутов.
+package middleware
+
+import "net/http"
+
+type Middleware func(http.Handler) http.Handler
+
+// Chain оборачивает h так, что первый middleware в списке выполняется первым.
+func Chain(h http.Handler, mws ...Middleware) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
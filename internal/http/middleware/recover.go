@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/response"
+)
+
+// Recover перехватывает панику в обработчике, логирует её со стеком и отвечает
+// стандартным ErrorResponse с кодом INTERNAL.
+func Recover(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				// ErrAbortHandler — штатный способ оборвать ответ, net/http обработает его сам.
+				if err, ok := p.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(p)
+				}
+
+				logger.ErrorContext(r.Context(), "panic in http handler",
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.Any("panic", p),
+					slog.String("stack", string(debug.Stack())),
+				)
+
+				// Если обработчик уже начал писать ответ, net/http проигнорирует повторный статус.
+				response.WriteError(w, apperror.Wrap(apperror.CodeInternal, "internal error", fmt.Errorf("panic: %v", p)))
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// ParseAuditFilter читает фильтры журнала аудита и параметры страницы limit и cursor.
+func ParseAuditFilter(r *http.Request) (domain.AuditFilter, error) {
+	query := r.URL.Query()
+
+	filter := domain.AuditFilter{
+		Actor:      query.Get("actor"),
+		Action:     query.Get("action"),
+		EntityType: query.Get("entity_type"),
+		EntityID:   query.Get("entity_id"),
+	}
+
+	from, err := parseTimeParam(query.Get("from"), "from")
+	if err != nil {
+		return filter, err
+	}
+	filter.From = from
+
+	to, err := parseTimeParam(query.Get("to"), "to")
+	if err != nil {
+		return filter, err
+	}
+	filter.To = to
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, validation.Invalid("limit", validation.RuleType, "limit must be an integer")
+		}
+		filter.Limit = n
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return filter, validation.Invalid("cursor", validation.RuleFormat, "cursor is invalid")
+		}
+		filter.BeforeID = n
+	}
+
+	return filter, nil
+}
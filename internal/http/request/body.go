@@ -0,0 +1,53 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// DecodeJSON разбирает JSON-тело запроса в v. Значение неверного типа
+// возвращается как ошибка поля с правилом type; превышение лимита тела остаётся
+// в цепочке ошибок, и WriteError отвечает на него 413.
+func DecodeJSON(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		message := typeErr.Field + " must be " + jsonTypeName(typeErr.Type)
+		appErr := validation.Invalid(typeErr.Field, validation.RuleType, message)
+		appErr.Err = err
+		return appErr
+	}
+
+	return apperror.Wrap(apperror.CodeValidation, "invalid json body", err)
+}
+
+func jsonTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
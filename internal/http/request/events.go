@@ -0,0 +1,66 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// ParseReviewEventFilter читает фильтры потока событий team_name и user_id.
+func ParseReviewEventFilter(r *http.Request) domain.ReviewEventFilter {
+	query := r.URL.Query()
+	return domain.ReviewEventFilter{
+		TeamName: query.Get("team_name"),
+		UserID:   query.Get("user_id"),
+	}
+}
+
+// ParseLastEventID читает позицию, с которой продолжить поток: заголовок
+// Last-Event-ID, который браузер шлёт при переподключении, или параметр
+// last_event_id. ok = false — позиция не передана.
+func ParseLastEventID(r *http.Request) (cursor domain.ReviewEventCursor, ok bool, err error) {
+	field, v := "Last-Event-ID", r.Header.Get("Last-Event-ID")
+	if v == "" {
+		field, v = "last_event_id", r.URL.Query().Get("last_event_id")
+	}
+	if v == "" {
+		return cursor, false, nil
+	}
+
+	cursor, err = ParseReviewEventID(v)
+	if err != nil {
+		return cursor, false, validation.Invalid(field, validation.RuleFormat, field+" is invalid")
+	}
+	return cursor, true, nil
+}
+
+// ParseReviewEventID разбирает id события: "T-N" — последнее событие записи
+// журнала N из транзакции T, "T-N.k" — событие k этой записи.
+func ParseReviewEventID(v string) (domain.ReviewEventCursor, error) {
+	txPart, rest, ok := strings.Cut(v, "-")
+	if !ok {
+		return domain.ReviewEventCursor{}, strconv.ErrSyntax
+	}
+	idPart, seqPart, hasSeq := strings.Cut(rest, ".")
+
+	txID, err := strconv.ParseInt(txPart, 10, 64)
+	if err != nil || txID < 0 {
+		return domain.ReviewEventCursor{}, strconv.ErrSyntax
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id < 1 {
+		return domain.ReviewEventCursor{}, strconv.ErrSyntax
+	}
+	if !hasSeq {
+		return domain.ReviewEventCursor{TxID: txID, ID: id, Seq: -1}, nil
+	}
+
+	seq, err := strconv.Atoi(seqPart)
+	if err != nil || seq < 0 {
+		return domain.ReviewEventCursor{}, strconv.ErrSyntax
+	}
+	return domain.ReviewEventCursor{TxID: txID, ID: id, Seq: seq}, nil
+}
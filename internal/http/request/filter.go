@@ -0,0 +1,46 @@
+package request
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// ParseStatsFilter читает общие query-параметры from, to, team_name и status.
+func ParseStatsFilter(r *http.Request) (domain.StatsFilter, error) {
+	query := r.URL.Query()
+
+	filter := domain.StatsFilter{
+		TeamName: query.Get("team_name"),
+		Status:   query.Get("status"),
+	}
+
+	from, err := parseTimeParam(query.Get("from"), "from")
+	if err != nil {
+		return filter, err
+	}
+	filter.From = from
+
+	to, err := parseTimeParam(query.Get("to"), "to")
+	if err != nil {
+		return filter, err
+	}
+	filter.To = to
+
+	return filter, nil
+}
+
+func parseTimeParam(value, name string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, validation.Invalid(name, validation.RuleFormat, name+" must be RFC3339 timestamp")
+	}
+
+	return &t, nil
+}
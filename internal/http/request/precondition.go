@@ -0,0 +1,41 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// ETag форматирует версию ресурса как сильный ETag: "3".
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch читает ожидаемую версию из заголовка If-Match. 0 — заголовка нет
+// или он равен "*": для существующего ресурса такое условие выполняется всегда.
+func ParseIfMatch(r *http.Request) (int64, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	invalid := validation.Invalid("If-Match", validation.RuleFormat, `If-Match must be a single strong ETag like "3"`)
+
+	unquoted, ok := strings.CutPrefix(value, `"`)
+	if !ok {
+		return 0, invalid
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return 0, invalid
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, invalid
+	}
+
+	return version, nil
+}
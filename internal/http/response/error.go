@@ -2,39 +2,101 @@ package response
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 )
 
+// ErrorResponse — тело любого ответа с ошибкой.
 type ErrorResponse struct {
-	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
-	} `json:"error"`
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody описывает ошибку: машинный код, текст для человека и, для VALIDATION,
+// ошибки отдельных полей.
+type ErrorBody struct {
+	Code    string        `json:"code" example:"VALIDATION"`
+	Message string        `json:"message" example:"pull_request_id is required; author_id is required"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+// ErrorDetail — нарушение правила для одного поля запроса.
+type ErrorDetail struct {
+	// Имя поля в теле, query-параметра или заголовка.
+	Field string `json:"field" example:"author_id"`
+	// Нарушенное правило: required, type, format, one_of, range, min_items, max_length, future, unsupported.
+	Rule    string `json:"rule" example:"required"`
+	Message string `json:"message" example:"author_id is required"`
+}
+
+// errorRecorder реализуется обёртками ответа из middleware.
+type errorRecorder interface {
+	RecordError(err error)
+}
+
+// RecordError передаёт ошибку обработчика во все обёртки ответа, которые её
+// учитывают (access-лог, спан запроса). Нужна и там, где ответ уже начал уходить
+// и WriteError вызвать нельзя.
+func RecordError(w http.ResponseWriter, err error) {
+	for w != nil {
+		if rec, ok := w.(errorRecorder); ok {
+			rec.RecordError(err)
+		}
+		u, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return
+		}
+		w = u.Unwrap()
+	}
 }
 
 func WriteError(w http.ResponseWriter, err error) {
+	RecordError(w, err)
+
 	appErr := apperror.From(err)
 
+	// Тело обрезано http.MaxBytesReader — сообщаем об этом, а не об ошибке разбора.
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		appErr = apperror.New(apperror.CodePayloadTooLarge,
+			fmt.Sprintf("request body exceeds %d bytes", maxBytesErr.Limit))
+	}
+
 	status := http.StatusInternalServerError
 	code := "INTERNAL"
 	message := "internal error"
+	var details []ErrorDetail
 
 	if appErr != nil {
 		code = string(appErr.Code)
 		message = appErr.Message
+		for _, d := range appErr.Details {
+			details = append(details, ErrorDetail{Field: d.Field, Rule: d.Rule, Message: d.Message})
+		}
 
 		switch appErr.Code {
 		case apperror.CodeValidation:
 			status = http.StatusBadRequest
+		case apperror.CodeUnauthorized:
+			status = http.StatusUnauthorized
+		case apperror.CodeForbidden:
+			status = http.StatusForbidden
 		case apperror.CodeNotFound:
 			status = http.StatusNotFound
+		case apperror.CodePayloadTooLarge:
+			status = http.StatusRequestEntityTooLarge
+		case apperror.CodeConflict:
+			status = http.StatusPreconditionFailed
 		case apperror.CodeTeamExists,
 			apperror.CodePRExists,
 			apperror.CodePRMerged,
 			apperror.CodeNotAssigned,
-			apperror.CodeNoCandidate:
+			apperror.CodeNoCandidate,
+			apperror.CodeConcurrentUpdate,
+			apperror.CodeIdempotencyKeyReused,
+			apperror.CodeIdempotencyInProgress:
 			status = http.StatusConflict
 		default:
 			status = http.StatusInternalServerError
@@ -47,6 +109,7 @@ func WriteError(w http.ResponseWriter, err error) {
 	resp := ErrorResponse{}
 	resp.Error.Code = code
 	resp.Error.Message = message
+	resp.Error.Details = details
 
 	_ = json.NewEncoder(w).Encode(resp)
 }
@@ -1,23 +1,110 @@
 package http
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/http/middleware"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/metrics"
+)
 
 type Router struct {
-	mux *http.ServeMux
+	mux         *http.ServeMux
+	metrics     *metrics.Metrics
+	middlewares []middleware.Middleware
+	bodyLimit   int64
+	auth        middleware.Middleware
+	idempotency middleware.Middleware
+}
+
+func NewRouter(m *metrics.Metrics) *Router {
+	return &Router{mux: http.NewServeMux(), metrics: m}
+}
+
+// Use добавляет middleware, которые оборачивают весь роутер, включая
+// запросы к незарегистрированным путям. Порядок вызова совпадает с порядком добавления.
+func (r *Router) Use(mws ...middleware.Middleware) {
+	r.middlewares = append(r.middlewares, mws...)
 }
 
-func NewRouter() *Router {
-	return &Router{mux: http.NewServeMux()}
+// SetBodyLimit задаёт ограничение тела запроса по умолчанию для маршрутов,
+// зарегистрированных после вызова. 0 — без ограничения.
+func (r *Router) SetBodyLimit(limit int64) {
+	r.bodyLimit = limit
+}
+
+// SetAuth задаёт middleware аутентификации для маршрутов, зарегистрированных
+// после вызова, кроме отмеченных Public.
+func (r *Router) SetAuth(mw middleware.Middleware) {
+	r.auth = mw
+}
+
+// SetIdempotency задаёт обработку заголовка Idempotency-Key для маршрутов,
+// зарегистрированных после вызова, кроме отмеченных Public. Middleware
+// выполняется после аутентификации: ключи хранятся отдельно для каждого вызывающего.
+func (r *Router) SetIdempotency(mw middleware.Middleware) {
+	r.idempotency = mw
 }
 
 func (r *Router) Handler() http.Handler {
-	return r.mux
+	return middleware.Chain(r.mux, r.middlewares...)
+}
+
+type routeOptions struct {
+	bodyLimit int64
+	public    bool
+}
+
+type RouteOption func(*routeOptions)
+
+// WithBodyLimit переопределяет ограничение тела запроса для маршрута.
+func WithBodyLimit(limit int64) RouteOption {
+	return func(o *routeOptions) {
+		o.bodyLimit = limit
+	}
+}
+
+// Public открывает маршрут без аутентификации (пробы, метрики, документация).
+func Public() RouteOption {
+	return func(o *routeOptions) {
+		o.public = true
+	}
 }
 
-func (r *Router) Handle(pattern string, h http.Handler) {
+// Handle регистрирует обработчик. pattern — паттерн http.ServeMux, в том числе
+// с методом и параметрами пути: "POST /api/v2/pull-requests/{id}/merge". В метрики,
+// логи и спаны маршрут попадает без метода — метод пишется отдельно.
+func (r *Router) Handle(pattern string, h http.Handler, opts ...RouteOption) {
+	route := pattern
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		route = strings.TrimSpace(path)
+	}
+
+	o := routeOptions{bodyLimit: r.bodyLimit}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if r.idempotency != nil && !o.public {
+		h = r.idempotency(h)
+	}
+	h = middleware.BodyLimit(o.bodyLimit)(h)
+	if r.auth != nil && !o.public {
+		h = r.auth(h)
+	}
+	if r.metrics != nil {
+		h = r.metrics.InstrumentHandler(route, h)
+	}
+
+	next := h
+	h = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		middleware.SetRoute(req, route)
+		next.ServeHTTP(w, req)
+	})
+
 	r.mux.Handle(pattern, h)
 }
 
-func (r *Router) HandleFunc(pattern string, h func(w http.ResponseWriter, r *http.Request)) {
-	r.mux.HandleFunc(pattern, h)
+func (r *Router) HandleFunc(pattern string, h func(w http.ResponseWriter, r *http.Request), opts ...RouteOption) {
+	r.Handle(pattern, http.HandlerFunc(h), opts...)
 }
@@ -0,0 +1,251 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatCSV  = "csv"
+)
+
+// Document — формат JSON/YAML документа импорта.
+type Document struct {
+	Teams        []TeamRecord        `json:"teams" yaml:"teams"`
+	PullRequests []PullRequestRecord `json:"pull_requests" yaml:"pull_requests"`
+}
+
+type TeamRecord struct {
+	TeamName string         `json:"team_name" yaml:"team_name"`
+	Members  []MemberRecord `json:"members" yaml:"members"`
+}
+
+type MemberRecord struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	IsActive *bool  `json:"is_active" yaml:"is_active"`
+}
+
+type PullRequestRecord struct {
+	PullRequestID     string     `json:"pull_request_id" yaml:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name" yaml:"pull_request_name"`
+	AuthorID          string     `json:"author_id" yaml:"author_id"`
+	Status            string     `json:"status" yaml:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers" yaml:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"created_at" yaml:"created_at"`
+	MergedAt          *time.Time `json:"merged_at" yaml:"merged_at"`
+}
+
+// CSVHeader — колонки CSV. Строка с type=member описывает участника команды,
+// type=pull_request — исторический PR; ревьюверы перечисляются через «;».
+var CSVHeader = []string{
+	"type", "team_name", "user_id", "username", "is_active",
+	"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at",
+}
+
+// FormatFromContentType сопоставляет Content-Type формату импорта.
+func FormatFromContentType(contentType string) string {
+	switch {
+	case strings.Contains(contentType, "yaml"):
+		return FormatYAML
+	case strings.Contains(contentType, "csv"):
+		return FormatCSV
+	default:
+		return FormatJSON
+	}
+}
+
+// Parse разбирает документ. Ошибки синтаксиса возвращаются как VALIDATION;
+// неверные значения в строках CSV собираются в ImportBatch.Errors, а смысловые
+// ошибки строк проверяет сервис импорта.
+func Parse(r io.Reader, format string) (*domain.ImportBatch, error) {
+	switch format {
+	case FormatJSON:
+		var doc Document
+		if err := json.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, apperror.Wrap(apperror.CodeValidation, "invalid json document", err)
+		}
+		return doc.toBatch(), nil
+	case FormatYAML:
+		var doc Document
+		if err := yaml.NewDecoder(r).Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+			return nil, apperror.Wrap(apperror.CodeValidation, "invalid yaml document", err)
+		}
+		return doc.toBatch(), nil
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return nil, apperror.New(apperror.CodeValidation, "format must be json, yaml or csv")
+	}
+}
+
+func (d *Document) toBatch() *domain.ImportBatch {
+	batch := &domain.ImportBatch{}
+
+	for i, t := range d.Teams {
+		team := domain.Team{Name: t.TeamName}
+		for _, m := range t.Members {
+			team.Members = append(team.Members, m.toUser(t.TeamName))
+		}
+		batch.Teams = append(batch.Teams, domain.ImportTeam{Row: i + 1, Team: team})
+	}
+
+	for i, p := range d.PullRequests {
+		batch.PullRequests = append(batch.PullRequests, domain.ImportPullRequest{Row: i + 1, PullRequest: p.toDomain()})
+	}
+
+	return batch
+}
+
+func (m MemberRecord) toUser(teamName string) domain.User {
+	isActive := true
+	if m.IsActive != nil {
+		isActive = *m.IsActive
+	}
+
+	return domain.User{
+		ID:       m.UserID,
+		Name:     m.Username,
+		TeamName: teamName,
+		IsActive: isActive,
+	}
+}
+
+func (p PullRequestRecord) toDomain() domain.PullRequest {
+	pr := domain.PullRequest{
+		PullRequestID:     p.PullRequestID,
+		PullRequestName:   p.PullRequestName,
+		AuthorID:          p.AuthorID,
+		PullRequestStatus: p.Status,
+		ReviewersID:       p.AssignedReviewers,
+		MergedAt:          p.MergedAt,
+	}
+	if pr.PullRequestStatus == "" {
+		pr.PullRequestStatus = string(domain.PRStatusOpen)
+	}
+	// Без created_at исторический PR считается созданным в момент слияния,
+	// а не в момент импорта — иначе merged_at оказался бы раньше created_at.
+	switch {
+	case p.CreatedAt != nil:
+		pr.CreatedAt = *p.CreatedAt
+	case p.MergedAt != nil:
+		pr.CreatedAt = *p.MergedAt
+	}
+
+	return pr
+}
+
+func parseCSV(r io.Reader) (*domain.ImportBatch, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeValidation, "invalid csv header", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["type"]; !ok {
+		return nil, apperror.New(apperror.CodeValidation, "csv header must contain type column")
+	}
+
+	batch := &domain.ImportBatch{}
+	addErr := func(section string, row int, field, message string) {
+		batch.Errors = append(batch.Errors, domain.ImportRowError{Section: section, Row: row, Field: field, Message: message})
+	}
+	teamIdx := make(map[string]int)
+	line := 1
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, apperror.Wrap(apperror.CodeValidation, fmt.Sprintf("invalid csv at line %d", line), err)
+		}
+
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		switch get("type") {
+		case "member":
+			teamName := get("team_name")
+			isActive := true
+			if v := get("is_active"); v != "" {
+				if isActive, err = strconv.ParseBool(v); err != nil {
+					addErr("teams", line, "is_active", "is_active must be boolean")
+				}
+			}
+
+			i, ok := teamIdx[teamName]
+			if !ok {
+				i = len(batch.Teams)
+				teamIdx[teamName] = i
+				batch.Teams = append(batch.Teams, domain.ImportTeam{Row: line, Team: domain.Team{Name: teamName}})
+			}
+			batch.Teams[i].MemberRows = append(batch.Teams[i].MemberRows, line)
+			batch.Teams[i].Team.Members = append(batch.Teams[i].Team.Members, domain.User{
+				ID:       get("user_id"),
+				Name:     get("username"),
+				TeamName: teamName,
+				IsActive: isActive,
+			})
+		case "pull_request":
+			rec := PullRequestRecord{
+				PullRequestID:   get("pull_request_id"),
+				PullRequestName: get("pull_request_name"),
+				AuthorID:        get("author_id"),
+				Status:          get("status"),
+			}
+			if v := get("assigned_reviewers"); v != "" {
+				rec.AssignedReviewers = strings.Split(v, ";")
+			}
+			if rec.CreatedAt, err = parseCSVTime(get("created_at")); err != nil {
+				addErr("pull_requests", line, "created_at", "created_at must be RFC3339 timestamp")
+			}
+			if rec.MergedAt, err = parseCSVTime(get("merged_at")); err != nil {
+				addErr("pull_requests", line, "merged_at", "merged_at must be RFC3339 timestamp")
+			}
+			batch.PullRequests = append(batch.PullRequests, domain.ImportPullRequest{Row: line, PullRequest: rec.toDomain()})
+		default:
+			addErr("", line, "type", "type must be member or pull_request")
+		}
+	}
+
+	return batch, nil
+}
+
+func parseCSVTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,24 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/tracelog"
+)
+
+// NewQueryTracer пишет SQL-запросы пула в logger на уровне debug. Ошибки
+// запросов тоже идут в debug: нарушения ограничений — штатная часть бизнес-логики,
+// а наружу их сообщает слой сервисов.
+func NewQueryTracer(logger *slog.Logger) *tracelog.TraceLog {
+	return &tracelog.TraceLog{
+		Logger: tracelog.LoggerFunc(func(ctx context.Context, _ tracelog.LogLevel, msg string, data map[string]any) {
+			attrs := make([]slog.Attr, 0, len(data))
+			for k, v := range data {
+				attrs = append(attrs, slog.Any(k, v))
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "pgx "+msg, attrs...)
+		}),
+		LogLevel: tracelog.LogLevelInfo,
+	}
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const scrapeTimeout = 5 * time.Second
+
+// OpenPullRequestsCounter отдаёт число открытых PR по командам авторов.
+type OpenPullRequestsCounter interface {
+	CountOpenPullRequestsByTeam(ctx context.Context) (map[string]int64, error)
+}
+
+type openPullRequestsCollector struct {
+	counter OpenPullRequestsCounter
+	desc    *prometheus.Desc
+	errors  prometheus.Counter
+}
+
+// NewOpenPullRequestsCollector считает gauge открытых PR по командам при каждом scrape.
+func NewOpenPullRequestsCollector(counter OpenPullRequestsCounter) prometheus.Collector {
+	return &openPullRequestsCollector{
+		counter: counter,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_pull_requests"),
+			"Number of open pull requests by author's team.",
+			[]string{"team"}, nil,
+		),
+		errors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "open_pull_requests_scrape_errors_total",
+			Help:      "Number of failed open pull requests queries during scrape.",
+		}),
+	}
+}
+
+func (c *openPullRequestsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	c.errors.Describe(ch)
+}
+
+func (c *openPullRequestsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	counts, err := c.counter.CountOpenPullRequestsByTeam(ctx)
+	if err != nil {
+		c.errors.Inc()
+	}
+	for team, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), team)
+	}
+
+	c.errors.Collect(ch)
+}
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns     *prometheus.Desc
+	idleConns         *prometheus.Desc
+	totalConns        *prometheus.Desc
+	maxConns          *prometheus.Desc
+	acquireCount      *prometheus.Desc
+	acquireDuration   *prometheus.Desc
+	emptyAcquire      *prometheus.Desc
+	canceledAcquire   *prometheus.Desc
+	newConns          *prometheus.Desc
+	maxLifetimeClosed *prometheus.Desc
+	maxIdleClosed     *prometheus.Desc
+}
+
+// NewPoolCollector экспортирует pgxpool.Stat.
+func NewPoolCollector(pool *pgxpool.Pool) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool:              pool,
+		acquiredConns:     desc("acquired_conns", "Number of currently acquired connections."),
+		idleConns:         desc("idle_conns", "Number of currently idle connections."),
+		totalConns:        desc("total_conns", "Total number of connections in the pool."),
+		maxConns:          desc("max_conns", "Maximum size of the pool."),
+		acquireCount:      desc("acquire_total", "Cumulative count of successful acquires."),
+		acquireDuration:   desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquire:      desc("empty_acquire_total", "Cumulative count of acquires that waited for a connection."),
+		canceledAcquire:   desc("canceled_acquire_total", "Cumulative count of acquires canceled by context."),
+		newConns:          desc("new_conns_total", "Cumulative count of new connections opened."),
+		maxLifetimeClosed: desc("max_lifetime_destroy_total", "Cumulative count of connections closed due to max lifetime."),
+		maxIdleClosed:     desc("max_idle_destroy_total", "Cumulative count of connections closed due to max idle time."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.canceledAcquire
+	ch <- c.newConns
+	ch <- c.maxLifetimeClosed
+	ch <- c.maxIdleClosed
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.newConns, prometheus.CounterValue, float64(s.NewConnsCount()))
+	ch <- prometheus.MustNewConstMetric(c.maxLifetimeClosed, prometheus.CounterValue, float64(s.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.maxIdleClosed, prometheus.CounterValue, float64(s.MaxIdleDestroyCount()))
+}
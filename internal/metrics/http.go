@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// InstrumentHandler считает запросы и их длительность. route — зарегистрированный
+// паттерн, а не фактический путь, чтобы не раздувать кардинальность меток.
+func (m *Metrics) InstrumentHandler(route string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		defer func() {
+			// Паника перехватывается выше по цепочке и превращается в 500 — учитываем её так же.
+			p := recover()
+			code := rec.status
+			if p != nil {
+				code = http.StatusInternalServerError
+			}
+
+			status := strconv.Itoa(code)
+			m.httpRequests.WithLabelValues(route, r.Method, status).Inc()
+			m.httpDuration.WithLabelValues(route, r.Method, status).Observe(time.Since(start).Seconds())
+
+			if p != nil {
+				panic(p)
+			}
+		}()
+
+		next.ServeHTTP(rec, r)
+	})
+}
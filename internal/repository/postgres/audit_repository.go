@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditRepository interface {
+	// Record пишет запись в транзакции Transactor из ctx, если она есть.
+	Record(ctx context.Context, entry *domain.AuditEntry) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+	// ListSince возвращает записи actions после позиции (afterTx, afterID) в
+	// порядке (tx_id, id), не больше limit. Отдаются только записи транзакций
+	// старше самой старой незавершённой: к ним новых записей уже не добавится,
+	// поэтому чтение не пропускает записи, зафиксированные не по порядку id.
+	ListSince(ctx context.Context, afterTx, afterID int64, actions []string, limit int) ([]domain.AuditEntry, error)
+	// Horizon — xmin текущего снимка: записи транзакций от него и новее ещё не
+	// отдаются ListSince.
+	Horizon(ctx context.Context) (int64, error)
+}
+
+type auditRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepository(db *pgxpool.Pool) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) Record(ctx context.Context, e *domain.AuditEntry) error {
+	const q = `
+		INSERT INTO audit_log (actor, action, entity_type, entity_id, before, after, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, occurred_at;
+	`
+
+	// Отсутствующий снимок пишется как SQL NULL.
+	if err := conn(ctx, r.db).QueryRow(ctx, q,
+		e.Actor,
+		e.Action,
+		e.EntityType,
+		e.EntityID,
+		nullableJSON(e.Before),
+		nullableJSON(e.After),
+		e.RequestID,
+	).Scan(&e.ID, &e.OccurredAt); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "insert audit entry", err)
+	}
+
+	return nil
+}
+
+func (r *auditRepository) List(ctx context.Context, f domain.AuditFilter) ([]domain.AuditEntry, error) {
+	const q = `
+		SELECT id, occurred_at, actor, action, entity_type, entity_id, before, after, request_id,
+		       tx_id::text::bigint
+		FROM audit_log
+		WHERE ($1 = '' OR actor = $1)
+		  AND ($2 = '' OR action = $2)
+		  AND ($3 = '' OR entity_type = $3)
+		  AND ($4 = '' OR entity_id = $4)
+		  AND ($5::timestamptz IS NULL OR occurred_at >= $5)
+		  AND ($6::timestamptz IS NULL OR occurred_at < $6)
+		  AND ($7 = 0 OR id < $7)
+		ORDER BY id DESC
+		LIMIT $8;
+	`
+
+	rows, err := r.db.Query(ctx, q,
+		f.Actor,
+		f.Action,
+		f.EntityType,
+		f.EntityID,
+		f.From,
+		f.To,
+		f.BeforeID,
+		f.Limit,
+	)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query audit log", err)
+	}
+
+	return scanAuditEntries(rows)
+}
+
+func (r *auditRepository) ListSince(ctx context.Context, afterTx, afterID int64, actions []string, limit int) ([]domain.AuditEntry, error) {
+	const q = `
+		SELECT id, occurred_at, actor, action, entity_type, entity_id, before, after, request_id,
+		       tx_id::text::bigint
+		FROM audit_log
+		WHERE (tx_id, id) > ($1::bigint::text::xid8, $2)
+		  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())
+		  AND action = ANY($3)
+		ORDER BY tx_id, id
+		LIMIT $4;
+	`
+
+	rows, err := r.db.Query(ctx, q, afterTx, afterID, actions, limit)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query audit log since", err)
+	}
+
+	return scanAuditEntries(rows)
+}
+
+func (r *auditRepository) Horizon(ctx context.Context) (int64, error) {
+	const q = `SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint`
+
+	var xmin int64
+	if err := r.db.QueryRow(ctx, q).Scan(&xmin); err != nil {
+		return 0, apperror.Wrap(apperror.CodeInternal, "query snapshot xmin", err)
+	}
+
+	return xmin, nil
+}
+
+func scanAuditEntries(rows pgx.Rows) ([]domain.AuditEntry, error) {
+	defer rows.Close()
+
+	res := make([]domain.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			e             domain.AuditEntry
+			before, after []byte
+		)
+		if err := rows.Scan(
+			&e.ID,
+			&e.OccurredAt,
+			&e.Actor,
+			&e.Action,
+			&e.EntityType,
+			&e.EntityID,
+			&before,
+			&after,
+			&e.RequestID,
+			&e.TxID,
+		); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan audit entry", err)
+		}
+		e.Before, e.After = before, after
+		res = append(res, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate audit log", err)
+	}
+
+	return res, nil
+}
+
+func nullableJSON(b []byte) any {
+	if b == nil {
+		return nil
+	}
+	return string(b)
+}
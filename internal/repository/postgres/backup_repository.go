@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BackupRepository interface {
+	// Snapshot вызывает fn внутри read-only транзакции REPEATABLE READ,
+	// чтобы все секции архива отражали одно и то же состояние БД.
+	Snapshot(ctx context.Context, fn func(snap BackupSnapshot) error) error
+	// Restore загружает данные в пустую БД одной транзакцией.
+	Restore(ctx context.Context, data *domain.Backup) error
+}
+
+// BackupSnapshot построчно читает таблицы в рамках одной транзакции.
+type BackupSnapshot interface {
+	Teams(ctx context.Context, fn func(name string) error) error
+	Users(ctx context.Context, fn func(domain.User) error) error
+	UserRoles(ctx context.Context, fn func(domain.RoleAssignment) error) error
+	PullRequests(ctx context.Context, fn func(domain.PullRequest) error) error
+	Reviewers(ctx context.Context, fn func(domain.Assignment) error) error
+	ActivityLog(ctx context.Context, fn func(domain.ActivityChange) error) error
+}
+
+type backupRepository struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewBackupRepository(db *pgxpool.Pool, logger *slog.Logger) BackupRepository {
+	return &backupRepository{db: db, logger: logger}
+}
+
+func (r *backupRepository) Snapshot(ctx context.Context, fn func(snap BackupSnapshot) error) (err error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
+	}
+	defer func() {
+		rollback(ctx, r.logger, tx)
+	}()
+
+	return fn(&backupSnapshot{tx: tx})
+}
+
+type backupSnapshot struct {
+	tx pgx.Tx
+}
+
+func (s *backupSnapshot) Teams(ctx context.Context, fn func(name string) error) error {
+	rows, err := s.tx.Query(ctx, `SELECT name FROM teams ORDER BY name`)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query teams backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan team backup", err)
+		}
+		if err := fn(name); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate teams backup", err)
+	}
+
+	return nil
+}
+
+func (s *backupSnapshot) Users(ctx context.Context, fn func(domain.User) error) error {
+	rows, err := s.tx.Query(ctx, `SELECT id, name, is_active, COALESCE(team_name, '') FROM users ORDER BY id`)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query users backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.IsActive, &u.TeamName); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan user backup", err)
+		}
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate users backup", err)
+	}
+
+	return nil
+}
+
+func (s *backupSnapshot) UserRoles(ctx context.Context, fn func(domain.RoleAssignment) error) error {
+	const q = `
+		SELECT user_id, role, COALESCE(team_name, ''), created_at
+		FROM user_roles
+		ORDER BY user_id, role, team_name NULLS FIRST;
+	`
+
+	rows, err := s.tx.Query(ctx, q)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query user roles backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.RoleAssignment
+		if err := rows.Scan(&a.UserID, &a.Role, &a.TeamName, &a.CreatedAt); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan user role backup", err)
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate user roles backup", err)
+	}
+
+	return nil
+}
+
+func (s *backupSnapshot) PullRequests(ctx context.Context, fn func(domain.PullRequest) error) error {
+	const q = `
+		SELECT id, name, author_id, status, created_at, merged_at, version
+		FROM pull_requests
+		ORDER BY created_at, id;
+	`
+
+	rows, err := s.tx.Query(ctx, q)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query pull requests backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pr domain.PullRequest
+		if err := rows.Scan(
+			&pr.PullRequestID,
+			&pr.PullRequestName,
+			&pr.AuthorID,
+			&pr.PullRequestStatus,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.Version,
+		); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan pull request backup", err)
+		}
+		if err := fn(pr); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate pull requests backup", err)
+	}
+
+	return nil
+}
+
+func (s *backupSnapshot) Reviewers(ctx context.Context, fn func(domain.Assignment) error) error {
+	const q = `
+		SELECT pull_request_id, reviewer_id, assigned_at, reviewed_at
+		FROM pull_request_reviewers
+		ORDER BY pull_request_id, reviewer_id;
+	`
+
+	rows, err := s.tx.Query(ctx, q)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query reviewers backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.Assignment
+		if err := rows.Scan(&a.PullRequestID, &a.ReviewerID, &a.AssignedAt, &a.ReviewedAt); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan reviewer backup", err)
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate reviewers backup", err)
+	}
+
+	return nil
+}
+
+func (s *backupSnapshot) ActivityLog(ctx context.Context, fn func(domain.ActivityChange) error) error {
+	rows, err := s.tx.Query(ctx, `SELECT user_id, is_active, changed_at FROM user_activity_log ORDER BY id`)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query activity log backup", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c domain.ActivityChange
+		if err := rows.Scan(&c.UserID, &c.IsActive, &c.ChangedAt); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan activity log backup", err)
+		}
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate activity log backup", err)
+	}
+
+	return nil
+}
+
+func (r *backupRepository) Restore(ctx context.Context, data *domain.Backup) (err error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
+	}
+	defer func() {
+		if err != nil {
+			rollback(ctx, r.logger, tx)
+		}
+	}()
+
+	// Блокируем таблицы, чтобы параллельная запись не попала между проверкой и загрузкой.
+	const lockTables = `LOCK TABLE teams, users, user_roles, pull_requests, pull_request_reviewers, user_activity_log IN EXCLUSIVE MODE`
+	if _, err = tx.Exec(ctx, lockTables); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "lock tables", err)
+	}
+
+	const checkEmpty = `
+		SELECT EXISTS (SELECT 1 FROM teams)
+		    OR EXISTS (SELECT 1 FROM users)
+		    OR EXISTS (SELECT 1 FROM pull_requests);
+	`
+	var notEmpty bool
+	if err = tx.QueryRow(ctx, checkEmpty).Scan(&notEmpty); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "check database is empty", err)
+	}
+	if notEmpty {
+		err = apperror.New(apperror.CodeValidation, "restore requires an empty database")
+		return err
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"teams"}, []string{"name"},
+		pgx.CopyFromSlice(len(data.Teams), func(i int) ([]any, error) {
+			return []any{data.Teams[i]}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy teams", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"users"}, []string{"id", "name", "is_active", "team_name"},
+		pgx.CopyFromSlice(len(data.Users), func(i int) ([]any, error) {
+			u := data.Users[i]
+			var teamName *string
+			if u.TeamName != "" {
+				teamName = &u.TeamName
+			}
+			return []any{u.ID, u.Name, u.IsActive, teamName}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy users", err)
+	}
+
+	// Триггер на users записал в журнал активности текущее время загрузки;
+	// заменяем эти записи историей из архива.
+	if _, err = tx.Exec(ctx, `DELETE FROM user_activity_log`); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "clear activity log", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"user_roles"}, []string{"user_id", "role", "team_name", "created_at"},
+		pgx.CopyFromSlice(len(data.UserRoles), func(i int) ([]any, error) {
+			a := data.UserRoles[i]
+			var teamName *string
+			if a.TeamName != "" {
+				teamName = &a.TeamName
+			}
+			return []any{a.UserID, a.Role, teamName, a.CreatedAt}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy user roles", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"pull_requests"}, []string{"id", "name", "author_id", "status", "created_at", "merged_at", "version"},
+		pgx.CopyFromSlice(len(data.PullRequests), func(i int) ([]any, error) {
+			pr := data.PullRequests[i]
+			return []any{pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.PullRequestStatus, pr.CreatedAt, pr.MergedAt, pr.Version}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy pull requests", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"pull_request_reviewers"}, []string{"pull_request_id", "reviewer_id", "assigned_at", "reviewed_at"},
+		pgx.CopyFromSlice(len(data.Reviewers), func(i int) ([]any, error) {
+			a := data.Reviewers[i]
+			return []any{a.PullRequestID, a.ReviewerID, a.AssignedAt, a.ReviewedAt}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy reviewers", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{"user_activity_log"}, []string{"user_id", "is_active", "changed_at"},
+		pgx.CopyFromSlice(len(data.ActivityLog), func(i int) ([]any, error) {
+			c := data.ActivityLog[i]
+			return []any{c.UserID, c.IsActive, c.ChangedAt}, nil
+		}),
+	); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "copy activity log", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "commit tx", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ExportRepository читает данные построчно и передаёт каждую строку в fn,
+// не накапливая результат в памяти. Ошибка из fn прерывает чтение и возвращается как есть.
+type ExportRepository interface {
+	StreamPullRequests(ctx context.Context, filter domain.StatsFilter, fn func(domain.PullRequest) error) error
+	// StreamAssignments отдаёт текущие назначения: снятые при переназначении
+	// ревьюверы в выгрузку не попадают, их история есть в журнале аудита.
+	StreamAssignments(ctx context.Context, filter domain.StatsFilter, fn func(domain.Assignment) error) error
+	StreamReviewerStats(ctx context.Context, filter domain.StatsFilter, fn func(domain.ReviewerStats) error) error
+}
+
+type exportRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewExportRepository(db *pgxpool.Pool) ExportRepository {
+	return &exportRepository{db: db}
+}
+
+func (r *exportRepository) StreamPullRequests(ctx context.Context, filter domain.StatsFilter, fn func(domain.PullRequest) error) error {
+	const q = `
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at,
+		       COALESCE(array_agg(prr.reviewer_id ORDER BY prr.reviewer_id)
+		                FILTER (WHERE prr.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		LEFT JOIN pull_request_reviewers prr
+		  ON prr.pull_request_id = pr.id
+		WHERE ($1::timestamptz IS NULL OR pr.created_at >= $1)
+		  AND ($2::timestamptz IS NULL OR pr.created_at < $2)
+		  AND ($3::text = '' OR pr.status = $3)
+		  AND ($4::text = '' OR au.team_name = $4)
+		GROUP BY pr.id
+		ORDER BY pr.created_at, pr.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.Status, filter.TeamName)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query pull requests export", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pr domain.PullRequest
+		if err := rows.Scan(
+			&pr.PullRequestID,
+			&pr.PullRequestName,
+			&pr.AuthorID,
+			&pr.PullRequestStatus,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.ReviewersID,
+		); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan pull request export", err)
+		}
+		if err := fn(pr); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate pull requests export", err)
+	}
+
+	return nil
+}
+
+func (r *exportRepository) StreamAssignments(ctx context.Context, filter domain.StatsFilter, fn func(domain.Assignment) error) error {
+	const q = `
+		SELECT pr.id, pr.name, pr.author_id, pr.status,
+		       prr.reviewer_id, COALESCE(ru.team_name, ''), prr.assigned_at, prr.reviewed_at
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+		  ON pr.id = prr.pull_request_id
+		JOIN users ru
+		  ON ru.id = prr.reviewer_id
+		WHERE ($1::timestamptz IS NULL OR prr.assigned_at >= $1)
+		  AND ($2::timestamptz IS NULL OR prr.assigned_at < $2)
+		  AND ($3::text = '' OR pr.status = $3)
+		  AND ($4::text = '' OR ru.team_name = $4)
+		ORDER BY prr.assigned_at, pr.id, prr.reviewer_id;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.Status, filter.TeamName)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "query assignments export", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.Assignment
+		if err := rows.Scan(
+			&a.PullRequestID,
+			&a.PullRequestName,
+			&a.AuthorID,
+			&a.Status,
+			&a.ReviewerID,
+			&a.ReviewerTeam,
+			&a.AssignedAt,
+			&a.ReviewedAt,
+		); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan assignment export", err)
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate assignments export", err)
+	}
+
+	return nil
+}
+
+func (r *exportRepository) StreamReviewerStats(ctx context.Context, filter domain.StatsFilter, fn func(domain.ReviewerStats) error) error {
+	return streamReviewerStats(ctx, r.db, filter, fn)
+}
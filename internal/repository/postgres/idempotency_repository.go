@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IdempotencyRepository interface {
+	// Acquire занимает ключ под новый запрос. Если ключ уже занят, возвращает
+	// существующую запись и ничего не меняет. Истёкшие ключи и зависшие дольше
+	// lockTimeout незавершённые запросы с тем же хэшем занимаются заново.
+	Acquire(ctx context.Context, rec *domain.IdempotencyRecord, lockTimeout time.Duration) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, rec *domain.IdempotencyRecord) error
+	// Release освобождает ключ незавершённого запроса, чтобы его можно было повторить.
+	Release(ctx context.Context, owner, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type idempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// acquireAttempts ограничивает повторы, когда занятый ключ освобождают между INSERT и SELECT.
+const acquireAttempts = 3
+
+func (r *idempotencyRepository) Acquire(ctx context.Context, rec *domain.IdempotencyRecord, lockTimeout time.Duration) (*domain.IdempotencyRecord, error) {
+	const insertQ = `
+		INSERT INTO idempotency_keys (owner, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (owner, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    status_code = NULL,
+		    headers = '{}',
+		    body = NULL,
+		    created_at = now(),
+		    completed_at = NULL,
+		    expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+		   OR (idempotency_keys.completed_at IS NULL
+		       AND idempotency_keys.request_hash = EXCLUDED.request_hash
+		       AND idempotency_keys.created_at <= now() - make_interval(secs => $5))
+		RETURNING created_at;
+	`
+	const selectQ = `
+		SELECT request_hash, COALESCE(status_code, 0), headers, body, created_at, completed_at, expires_at
+		FROM idempotency_keys
+		WHERE owner = $1 AND key = $2;
+	`
+
+	for range acquireAttempts {
+		err := r.db.QueryRow(ctx, insertQ, rec.Owner, rec.Key, rec.RequestHash, rec.ExpiresAt, lockTimeout.Seconds()).
+			Scan(&rec.CreatedAt)
+		if err == nil {
+			return nil, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperror.Wrap(apperror.CodeInternal, "acquire idempotency key", err)
+		}
+
+		existing := domain.IdempotencyRecord{Owner: rec.Owner, Key: rec.Key}
+		err = r.db.QueryRow(ctx, selectQ, rec.Owner, rec.Key).Scan(
+			&existing.RequestHash,
+			&existing.StatusCode,
+			&existing.Headers,
+			&existing.Body,
+			&existing.CreatedAt,
+			&existing.CompletedAt,
+			&existing.ExpiresAt,
+		)
+		if err == nil {
+			return &existing, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperror.Wrap(apperror.CodeInternal, "get idempotency key", err)
+		}
+	}
+
+	return nil, apperror.New(apperror.CodeInternal, "acquire idempotency key: too much contention")
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	const q = `
+		UPDATE idempotency_keys
+		SET status_code = $3, headers = $4, body = $5, completed_at = now()
+		WHERE owner = $1 AND key = $2 AND completed_at IS NULL
+		RETURNING completed_at;
+	`
+
+	err := r.db.QueryRow(ctx, q, rec.Owner, rec.Key, rec.StatusCode, rec.Headers, rec.Body).Scan(&rec.CompletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperror.New(apperror.CodeNotFound, "idempotency key not found")
+		}
+		return apperror.Wrap(apperror.CodeInternal, "complete idempotency key", err)
+	}
+
+	return nil
+}
+
+func (r *idempotencyRepository) Release(ctx context.Context, owner, key string) error {
+	const q = `DELETE FROM idempotency_keys WHERE owner = $1 AND key = $2 AND completed_at IS NULL`
+
+	if _, err := r.db.Exec(ctx, q, owner, key); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "release idempotency key", err)
+	}
+
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, apperror.Wrap(apperror.CodeInternal, "delete expired idempotency keys", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
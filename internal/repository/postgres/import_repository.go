@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ImportRepository interface {
+	ExistingTeams(ctx context.Context, names []string) (map[string]struct{}, error)
+	// ExistingUsers возвращает команду каждого уже заведённого пользователя.
+	ExistingUsers(ctx context.Context, ids []string) (map[string]string, error)
+	ExistingPullRequests(ctx context.Context, ids []string) (map[string]struct{}, error)
+	// Apply загружает пакет в одной транзакции. При commit=false транзакция
+	// откатывается — так dry-run проверяет и ограничения БД.
+	Apply(ctx context.Context, batch *domain.ImportBatch, commit bool) error
+}
+
+type importRepository struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewImportRepository(db *pgxpool.Pool, logger *slog.Logger) ImportRepository {
+	return &importRepository{db: db, logger: logger}
+}
+
+func (r *importRepository) ExistingTeams(ctx context.Context, names []string) (map[string]struct{}, error) {
+	return r.existing(ctx, `SELECT name FROM teams WHERE name = ANY($1)`, names, "teams")
+}
+
+func (r *importRepository) ExistingUsers(ctx context.Context, ids []string) (map[string]string, error) {
+	res := make(map[string]string)
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	rows, err := r.db.Query(ctx, `SELECT id, team_name FROM users WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query existing users", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, team string
+		if err := rows.Scan(&id, &team); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan existing users", err)
+		}
+		res[id] = team
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate existing users", err)
+	}
+
+	return res, nil
+}
+
+func (r *importRepository) ExistingPullRequests(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	return r.existing(ctx, `SELECT id FROM pull_requests WHERE id = ANY($1)`, ids, "pull requests")
+}
+
+func (r *importRepository) existing(ctx context.Context, q string, keys []string, entity string) (map[string]struct{}, error) {
+	res := make(map[string]struct{})
+	if len(keys) == 0 {
+		return res, nil
+	}
+
+	rows, err := r.db.Query(ctx, q, keys)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query existing "+entity, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan existing "+entity, err)
+		}
+		res[key] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate existing "+entity, err)
+	}
+
+	return res, nil
+}
+
+func (r *importRepository) Apply(ctx context.Context, batch *domain.ImportBatch, commit bool) (err error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
+	}
+	defer func() {
+		if err != nil || !commit {
+			rollback(ctx, r.logger, tx)
+		}
+	}()
+
+	const insertTeam = `INSERT INTO teams (name) VALUES ($1)`
+
+	const insertUser = `
+		INSERT INTO users (id, name, is_active, team_name)
+		VALUES ($1, $2, $3, $4);
+	`
+
+	for _, it := range batch.Teams {
+		if _, err = tx.Exec(ctx, insertTeam, it.Team.Name); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, fmt.Sprintf("insert team %s", it.Team.Name), err)
+		}
+		for _, m := range it.Team.Members {
+			if _, err = tx.Exec(ctx, insertUser, m.ID, m.Name, m.IsActive, it.Team.Name); err != nil {
+				return apperror.Wrap(apperror.CodeInternal, fmt.Sprintf("insert user %s", m.ID), err)
+			}
+		}
+	}
+
+	const insertPR = `
+		INSERT INTO pull_requests (id, name, author_id, status, created_at, merged_at)
+		VALUES ($1, $2, $3, $4, COALESCE($5, now()), $6);
+	`
+
+	const insertReviewer = `
+		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, assigned_at)
+		VALUES ($1, $2, COALESCE($3, now()));
+	`
+
+	for _, ip := range batch.PullRequests {
+		pr := ip.PullRequest
+
+		var createdAt *time.Time
+		if !pr.CreatedAt.IsZero() {
+			createdAt = &pr.CreatedAt
+		}
+
+		if _, err = tx.Exec(ctx, insertPR,
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			pr.PullRequestStatus,
+			createdAt,
+			pr.MergedAt,
+		); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, fmt.Sprintf("insert pull request %s", pr.PullRequestID), err)
+		}
+
+		for _, rid := range pr.ReviewersID {
+			if _, err = tx.Exec(ctx, insertReviewer, pr.PullRequestID, rid, createdAt); err != nil {
+				return apperror.Wrap(apperror.CodeInternal, fmt.Sprintf("insert reviewer %s", rid), err)
+			}
+		}
+	}
+
+	if !commit {
+		return nil
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "commit tx", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
@@ -12,36 +13,48 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// PullRequestRepository меняет PR только при совпадении версии: expectedVersion —
+// версия, от которой отталкивался вызывающий, 0 — без проверки. Если PR успел
+// измениться, возвращается CONFLICT. Каждое изменение увеличивает версию.
 type PullRequestRepository interface {
 	Create(ctx context.Context, request *domain.PullRequest) error
-	Merge(ctx context.Context, id string) (*domain.PullRequest, error)
-	ReAssign(ctx context.Context, prID, oldReviewerID, newReviewerID string) (*domain.PullRequest, error)
+	// Merge сливает PR и сообщает, изменился ли статус: повторный merge
+	// возвращает false. Статус до изменения читается под блокировкой строки.
+	Merge(ctx context.Context, id string, expectedVersion int64) (*domain.PullRequest, bool, error)
+	ReAssign(ctx context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) (*domain.PullRequest, error)
 	GetByID(ctx context.Context, id string) (*domain.PullRequest, error)
+	// MarkReviewed отмечает ревью и сообщает, изменилось ли что-то: повторная
+	// отметка возвращает false.
+	MarkReviewed(ctx context.Context, prID, reviewerID string, expectedVersion int64) (bool, error)
+	// GetByIDs возвращает найденные PR из ids вместе с ревьюверами; отсутствующие пропускаются.
+	GetByIDs(ctx context.Context, ids []string) ([]domain.PullRequest, error)
+	List(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
 }
 
 type pullRequestRepository struct {
-	db *pgxpool.Pool
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
-func NewPullRequestRepository(dbPool *pgxpool.Pool) PullRequestRepository {
-	return &pullRequestRepository{db: dbPool}
+func NewPullRequestRepository(dbPool *pgxpool.Pool, logger *slog.Logger) PullRequestRepository {
+	return &pullRequestRepository{db: dbPool, logger: logger}
 }
 
 func (r *pullRequestRepository) Create(ctx context.Context, pr *domain.PullRequest) (err error) {
-	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
 	}
 	defer func() {
 		if err != nil {
-			_ = tx.Rollback(ctx)
+			rollback(ctx, r.logger, tx)
 		}
 	}()
 
 	const insertPR = `
 		INSERT INTO pull_requests (id, name, author_id, status)
 		VALUES ($1, $2, $3, $4)
-		RETURNING created_at, merged_at;
+		RETURNING created_at, merged_at, version;
 	`
 
 	if err = tx.QueryRow(ctx, insertPR,
@@ -49,7 +62,7 @@ func (r *pullRequestRepository) Create(ctx context.Context, pr *domain.PullReque
 		pr.PullRequestName,
 		pr.AuthorID,
 		pr.PullRequestStatus,
-	).Scan(&pr.CreatedAt, &pr.MergedAt); err != nil {
+	).Scan(&pr.CreatedAt, &pr.MergedAt, &pr.Version); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return apperror.New(apperror.CodePRExists, "pull_request_id already exists")
@@ -76,30 +89,46 @@ func (r *pullRequestRepository) Create(ctx context.Context, pr *domain.PullReque
 	return nil
 }
 
-func (r *pullRequestRepository) Merge(ctx context.Context, id string) (*domain.PullRequest, error) {
+func (r *pullRequestRepository) Merge(ctx context.Context, id string, expectedVersion int64) (_ *domain.PullRequest, merged bool, err error) {
+	// Повторный merge ничего не меняет, поэтому версию не увеличивает. Прежний
+	// статус берётся из заблокированной строки: из двух параллельных merge
+	// изменение увидит только первый.
 	const q = `
-		UPDATE pull_requests
-		SET status   = 'MERGED',
-		    merged_at = COALESCE(merged_at, now())
-		WHERE id = $1
-		RETURNING id, name, author_id, status, created_at, merged_at;
+		WITH old AS (
+			SELECT id, status
+			FROM pull_requests
+			WHERE id = $1 AND ($2::bigint = 0 OR version = $2)
+			FOR UPDATE
+		)
+		UPDATE pull_requests pr
+		SET status    = 'MERGED',
+		    merged_at = COALESCE(pr.merged_at, now()),
+		    version   = CASE WHEN old.status = 'MERGED' THEN pr.version ELSE pr.version + 1 END
+		FROM old
+		WHERE pr.id = old.id
+		RETURNING pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version,
+		          old.status <> 'MERGED';
 	`
 
+	db := conn(ctx, r.db)
+
 	var pr domain.PullRequest
 
-	err := r.db.QueryRow(ctx, q, id).Scan(
+	err = db.QueryRow(ctx, q, id, expectedVersion).Scan(
 		&pr.PullRequestID,
 		&pr.PullRequestName,
 		&pr.AuthorID,
 		&pr.PullRequestStatus,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.Version,
+		&merged,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, apperror.New(apperror.CodeNotFound, "pull request not found")
+			return nil, false, r.staleOrMissing(ctx, db, id)
 		}
-		return nil, apperror.Wrap(apperror.CodeInternal, "merge pull request", err)
+		return nil, false, apperror.Wrap(apperror.CodeInternal, "merge pull request", err)
 	}
 
 	const reviewersQuery = `
@@ -109,44 +138,64 @@ func (r *pullRequestRepository) Merge(ctx context.Context, id string) (*domain.P
 		ORDER BY reviewer_id;
 	`
 
-	rows, err := r.db.Query(ctx, reviewersQuery, id)
+	rows, err := db.Query(ctx, reviewersQuery, id)
 	if err != nil {
-		return nil, apperror.Wrap(apperror.CodeInternal, "query reviewers", err)
+		return nil, false, apperror.Wrap(apperror.CodeInternal, "query reviewers", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
 		var rid string
 		if err := rows.Scan(&rid); err != nil {
-			return nil, apperror.Wrap(apperror.CodeInternal, "scan reviewer_id", err)
+			return nil, false, apperror.Wrap(apperror.CodeInternal, "scan reviewer_id", err)
 		}
 		pr.ReviewersID = append(pr.ReviewersID, rid)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, apperror.Wrap(apperror.CodeInternal, "iterate reviewers", err)
+		return nil, false, apperror.Wrap(apperror.CodeInternal, "iterate reviewers", err)
 	}
 
-	return &pr, nil
+	return &pr, merged, nil
 }
 
-func (r *pullRequestRepository) ReAssign(ctx context.Context, prID, oldReviewerID, newReviewerID string) (*domain.PullRequest, error) {
-	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+func (r *pullRequestRepository) ReAssign(ctx context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) (*domain.PullRequest, error) {
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return nil, apperror.Wrap(apperror.CodeInternal, "begin tx", err)
 	}
 	defer func() {
 		if err != nil {
-			_ = tx.Rollback(ctx)
+			rollback(ctx, r.logger, tx)
 		}
 	}()
 
+	// Версия увеличивается первой: строка PR блокируется до конца транзакции,
+	// и параллельное переназначение по той же версии получит CONFLICT.
+	const bumpVersion = `
+        UPDATE pull_requests
+        SET version = version + 1
+        WHERE id = $1 AND ($2::bigint = 0 OR version = $2);
+    `
+
+	tag, execErr := tx.Exec(ctx, bumpVersion, prID, expectedVersion)
+	if execErr != nil {
+		err = apperror.Wrap(apperror.CodeInternal, "update pull request version", execErr)
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		err = r.staleOrMissing(ctx, tx, prID)
+		return nil, err
+	}
+
 	const updateReviewer = `
         UPDATE pull_request_reviewers
-        SET reviewer_id = $3
+        SET reviewer_id = $3,
+            assigned_at = now(),
+            reviewed_at = NULL
         WHERE pull_request_id = $1 AND reviewer_id = $2;
     `
 
-	tag, execErr := tx.Exec(ctx, updateReviewer, prID, oldReviewerID, newReviewerID)
+	tag, execErr = tx.Exec(ctx, updateReviewer, prID, oldReviewerID, newReviewerID)
 	if execErr != nil {
 		err = apperror.Wrap(apperror.CodeInternal, "update reviewer", execErr)
 		return nil, err
@@ -157,7 +206,7 @@ func (r *pullRequestRepository) ReAssign(ctx context.Context, prID, oldReviewerI
 	}
 
 	const selectPR = `
-        SELECT id, name, author_id, status, created_at, merged_at
+        SELECT id, name, author_id, status, created_at, merged_at, version
         FROM pull_requests
         WHERE id = $1;
     `
@@ -170,6 +219,7 @@ func (r *pullRequestRepository) ReAssign(ctx context.Context, prID, oldReviewerI
 		&pr.PullRequestStatus,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.Version,
 	)
 	if err != nil {
 		err = apperror.Wrap(apperror.CodeInternal, "select pull request", err)
@@ -212,7 +262,7 @@ func (r *pullRequestRepository) ReAssign(ctx context.Context, prID, oldReviewerI
 
 func (r *pullRequestRepository) GetByID(ctx context.Context, id string) (*domain.PullRequest, error) {
 	const q = `
-        SELECT id, name, author_id, status, created_at, merged_at
+        SELECT id, name, author_id, status, created_at, merged_at, version
         FROM pull_requests
         WHERE id = $1;
     `
@@ -226,6 +276,7 @@ func (r *pullRequestRepository) GetByID(ctx context.Context, id string) (*domain
 		&pr.PullRequestStatus,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -259,3 +310,145 @@ func (r *pullRequestRepository) GetByID(ctx context.Context, id string) (*domain
 
 	return &pr, nil
 }
+
+func (r *pullRequestRepository) MarkReviewed(ctx context.Context, prID, reviewerID string, expectedVersion int64) (_ bool, err error) {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return false, apperror.Wrap(apperror.CodeInternal, "begin tx", err)
+	}
+	defer func() {
+		if err != nil {
+			rollback(ctx, r.logger, tx)
+		}
+	}()
+
+	var version int64
+	err = tx.QueryRow(ctx, `SELECT version FROM pull_requests WHERE id = $1 FOR UPDATE`, prID).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, apperror.New(apperror.CodeNotFound, "pull request not found")
+		}
+		return false, apperror.Wrap(apperror.CodeInternal, "lock pull request", err)
+	}
+	if expectedVersion != 0 && version != expectedVersion {
+		return false, apperror.New(apperror.CodeConflict, "pull request was modified")
+	}
+
+	const q = `
+		UPDATE pull_request_reviewers
+		SET reviewed_at = now()
+		WHERE pull_request_id = $1 AND reviewer_id = $2 AND reviewed_at IS NULL;
+	`
+
+	tag, err := tx.Exec(ctx, q, prID, reviewerID)
+	if err != nil {
+		return false, apperror.Wrap(apperror.CodeInternal, "mark reviewed", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		// Ревью уже отмечено (повторный вызов ничего не меняет) или ревьювер не назначен.
+		var assigned bool
+		const assignedQ = `SELECT EXISTS (SELECT 1 FROM pull_request_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2)`
+		if err = tx.QueryRow(ctx, assignedQ, prID, reviewerID).Scan(&assigned); err != nil {
+			return false, apperror.Wrap(apperror.CodeInternal, "check reviewer", err)
+		}
+		if !assigned {
+			return false, apperror.New(apperror.CodeNotAssigned, "reviewer is not assigned to this PR")
+		}
+	} else if _, err = tx.Exec(ctx, `UPDATE pull_requests SET version = version + 1 WHERE id = $1`, prID); err != nil {
+		return false, apperror.Wrap(apperror.CodeInternal, "update pull request version", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, apperror.Wrap(apperror.CodeInternal, "commit tx", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// staleOrMissing объясняет, почему условное изменение PR не затронуло ни одной
+// строки: PR не существует или его версия уже другая.
+func (r *pullRequestRepository) staleOrMissing(ctx context.Context, q querier, id string) error {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pull_requests WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "check pull request", err)
+	}
+	if !exists {
+		return apperror.New(apperror.CodeNotFound, "pull request not found")
+	}
+	return apperror.New(apperror.CodeConflict, "pull request was modified")
+}
+
+func (r *pullRequestRepository) GetByIDs(ctx context.Context, ids []string) ([]domain.PullRequest, error) {
+	const q = `
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version,
+		       ARRAY(SELECT prr.reviewer_id
+		               FROM pull_request_reviewers prr
+		              WHERE prr.pull_request_id = pr.id
+		              ORDER BY prr.reviewer_id)
+		FROM pull_requests pr
+		WHERE pr.id = ANY($1)
+		ORDER BY pr.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, ids)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query pull requests by ids", err)
+	}
+
+	return scanPullRequests(rows)
+}
+
+func (r *pullRequestRepository) List(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error) {
+	const q = `
+		SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version,
+		       ARRAY(SELECT prr.reviewer_id
+		               FROM pull_request_reviewers prr
+		              WHERE prr.pull_request_id = pr.id
+		              ORDER BY prr.reviewer_id)
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		WHERE ($1::text = '' OR au.team_name = $1)
+		  AND ($2::text = '' OR pr.author_id = $2)
+		  AND ($3::text = '' OR pr.status = $3)
+		ORDER BY pr.created_at DESC, pr.id
+		LIMIT $4;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.TeamName, filter.AuthorID, filter.Status, filter.Limit)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "list pull requests", err)
+	}
+
+	return scanPullRequests(rows)
+}
+
+// scanPullRequests читает строки вида (PR, массив ревьюверов).
+func scanPullRequests(rows pgx.Rows) ([]domain.PullRequest, error) {
+	defer rows.Close()
+
+	var res []domain.PullRequest
+
+	for rows.Next() {
+		var pr domain.PullRequest
+		if err := rows.Scan(
+			&pr.PullRequestID,
+			&pr.PullRequestName,
+			&pr.AuthorID,
+			&pr.PullRequestStatus,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.Version,
+			&pr.ReviewersID,
+		); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan pull request", err)
+		}
+		res = append(res, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate pull requests", err)
+	}
+
+	return res, nil
+}
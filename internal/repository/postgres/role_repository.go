@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RoleRepository interface {
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	// Grants возвращает права пользователя: из назначенных ролей и из ролей по умолчанию.
+	Grants(ctx context.Context, userID string) ([]domain.Grant, error)
+	// RolePermissions возвращает права перечисленных ролей; неизвестные роли пропускаются.
+	RolePermissions(ctx context.Context, roles []string) (map[string][]domain.Permission, error)
+	ListAssignments(ctx context.Context, userID string) ([]domain.RoleAssignment, error)
+	Assign(ctx context.Context, a *domain.RoleAssignment) error
+	Unassign(ctx context.Context, a *domain.RoleAssignment) error
+}
+
+type roleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRoleRepository(db *pgxpool.Pool) RoleRepository {
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	const q = `
+		SELECT r.name, r.description, r.is_default,
+		       COALESCE(array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL), '{}')
+		FROM roles r
+		LEFT JOIN role_permissions rp ON rp.role = r.name
+		GROUP BY r.name, r.description, r.is_default
+		ORDER BY r.name;
+	`
+
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query roles", err)
+	}
+	defer rows.Close()
+
+	res := make([]domain.Role, 0)
+	for rows.Next() {
+		var (
+			role  domain.Role
+			perms []string
+		)
+		if err := rows.Scan(&role.Name, &role.Description, &role.IsDefault, &perms); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan role", err)
+		}
+		role.Permissions = make([]domain.Permission, 0, len(perms))
+		for _, p := range perms {
+			role.Permissions = append(role.Permissions, domain.Permission(p))
+		}
+		res = append(res, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate roles", err)
+	}
+
+	return res, nil
+}
+
+func (r *roleRepository) Grants(ctx context.Context, userID string) ([]domain.Grant, error) {
+	const q = `
+		SELECT rp.permission, COALESCE(ur.team_name, '')
+		FROM user_roles ur
+		JOIN role_permissions rp ON rp.role = ur.role
+		WHERE ur.user_id = $1
+		UNION
+		SELECT rp.permission, ''
+		FROM roles r
+		JOIN role_permissions rp ON rp.role = r.name
+		WHERE r.is_default AND EXISTS (SELECT 1 FROM users WHERE id = $1);
+	`
+
+	rows, err := r.db.Query(ctx, q, userID)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query grants", err)
+	}
+	defer rows.Close()
+
+	res := make([]domain.Grant, 0)
+	for rows.Next() {
+		var perm, team string
+		if err := rows.Scan(&perm, &team); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan grant", err)
+		}
+		res = append(res, domain.Grant{Permission: domain.Permission(perm), TeamName: team})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate grants", err)
+	}
+
+	return res, nil
+}
+
+func (r *roleRepository) RolePermissions(ctx context.Context, roles []string) (map[string][]domain.Permission, error) {
+	const q = `SELECT role, permission FROM role_permissions WHERE role = ANY($1)`
+
+	rows, err := r.db.Query(ctx, q, roles)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query role permissions", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]domain.Permission)
+	for rows.Next() {
+		var role, perm string
+		if err := rows.Scan(&role, &perm); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan role permission", err)
+		}
+		res[role] = append(res[role], domain.Permission(perm))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate role permissions", err)
+	}
+
+	return res, nil
+}
+
+func (r *roleRepository) ListAssignments(ctx context.Context, userID string) ([]domain.RoleAssignment, error) {
+	const q = `
+		SELECT user_id, role, COALESCE(team_name, ''), created_at
+		FROM user_roles
+		WHERE $1 = '' OR user_id = $1
+		ORDER BY user_id, role, team_name NULLS FIRST;
+	`
+
+	rows, err := r.db.Query(ctx, q, userID)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query role assignments", err)
+	}
+	defer rows.Close()
+
+	res := make([]domain.RoleAssignment, 0)
+	for rows.Next() {
+		var a domain.RoleAssignment
+		if err := rows.Scan(&a.UserID, &a.Role, &a.TeamName, &a.CreatedAt); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan role assignment", err)
+		}
+		res = append(res, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate role assignments", err)
+	}
+
+	return res, nil
+}
+
+// Assign идемпотентен: повторное назначение возвращает дату первого.
+func (r *roleRepository) Assign(ctx context.Context, a *domain.RoleAssignment) error {
+	const q = `
+		WITH ins AS (
+			INSERT INTO user_roles (user_id, role, team_name)
+			VALUES ($1, $2, NULLIF($3, ''))
+			ON CONFLICT ON CONSTRAINT user_roles_uniq DO NOTHING
+			RETURNING created_at
+		)
+		SELECT created_at FROM ins
+		UNION ALL
+		SELECT created_at FROM user_roles
+		WHERE user_id = $1 AND role = $2 AND team_name IS NOT DISTINCT FROM NULLIF($3, '')
+		LIMIT 1;
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, q, a.UserID, a.Role, a.TeamName).Scan(&a.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			switch pgErr.ConstraintName {
+			case "user_roles_role_fkey":
+				return apperror.New(apperror.CodeNotFound, "role not found")
+			case "user_roles_team_name_fkey":
+				return apperror.New(apperror.CodeNotFound, "team not found")
+			default:
+				return apperror.New(apperror.CodeNotFound, "user not found")
+			}
+		}
+		return apperror.Wrap(apperror.CodeInternal, "insert role assignment", err)
+	}
+
+	return nil
+}
+
+func (r *roleRepository) Unassign(ctx context.Context, a *domain.RoleAssignment) error {
+	const q = `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role = $2 AND team_name IS NOT DISTINCT FROM NULLIF($3, '');
+	`
+
+	tag, err := conn(ctx, r.db).Exec(ctx, q, a.UserID, a.Role, a.TeamName)
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "delete role assignment", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperror.New(apperror.CodeNotFound, "role assignment not found")
+	}
+
+	return nil
+}
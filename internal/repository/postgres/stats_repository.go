@@ -9,7 +9,15 @@ import (
 )
 
 type StatsRepository interface {
-	GetReviewerStats(ctx context.Context) ([]domain.ReviewerStats, error)
+	GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error)
+	GetTeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+	GetMergeLatencyByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamLatency, error)
+	GetMergeLatencyByAuthor(ctx context.Context, filter domain.StatsFilter) ([]domain.AuthorLatency, error)
+	GetMergeLatencyTrend(ctx context.Context, filter domain.StatsFilter) ([]domain.LatencyTrendBucket, error)
+	GetFirstReviewLatency(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerLatency, error)
+	GetMemberLoads(ctx context.Context, filter domain.StatsFilter) ([]domain.MemberLoad, error)
+	GetActivityChanges(ctx context.Context, filter domain.StatsFilter) ([]domain.ActivityChange, error)
+	CountOpenPullRequestsByTeam(ctx context.Context) (map[string]int64, error)
 }
 
 type statsRepository struct {
@@ -20,33 +28,372 @@ func NewStatsRepository(db *pgxpool.Pool) StatsRepository {
 	return &statsRepository{db: db}
 }
 
-func (r *statsRepository) GetReviewerStats(ctx context.Context) ([]domain.ReviewerStats, error) {
-	const q = `
-		SELECT u.id, u.name, COUNT(prr.pull_request_id) AS assigned_count
+func (r *statsRepository) GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error) {
+	var res []domain.ReviewerStats
+	err := streamReviewerStats(ctx, r.db, filter, func(s domain.ReviewerStats) error {
+		res = append(res, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// streamReviewerStats — общий запрос /stats/reviewers и /export/reviewerStats:
+// строки передаются в fn по мере чтения.
+func streamReviewerStats(ctx context.Context, q querier, filter domain.StatsFilter, fn func(domain.ReviewerStats) error) error {
+	const query = `
+		SELECT u.id, u.name,
+		       COUNT(pr.id) AS assigned_count,
+		       COUNT(pr.id) FILTER (WHERE pr.status = 'OPEN') AS open_count,
+		       COUNT(pr.id) FILTER (WHERE pr.status = 'MERGED') AS merged_count
 		FROM users u
 		LEFT JOIN pull_request_reviewers prr
 		  ON prr.reviewer_id = u.id
+		LEFT JOIN pull_requests pr
+		  ON pr.id = prr.pull_request_id
+		 AND ($1::timestamptz IS NULL OR pr.created_at >= $1)
+		 AND ($2::timestamptz IS NULL OR pr.created_at < $2)
+		 AND ($3::text = '' OR pr.status = $3)
+		WHERE ($4::text = '' OR u.team_name = $4)
 		GROUP BY u.id, u.name
 		ORDER BY assigned_count DESC, u.name;
 	`
 
-	rows, err := r.db.Query(ctx, q)
+	rows, err := q.Query(ctx, query, filter.From, filter.To, filter.Status, filter.TeamName)
 	if err != nil {
-		return nil, apperror.Wrap(apperror.CodeInternal, "query reviewer stats", err)
+		return apperror.Wrap(apperror.CodeInternal, "query reviewer stats", err)
 	}
 	defer rows.Close()
 
-	var res []domain.ReviewerStats
-
 	for rows.Next() {
 		var s domain.ReviewerStats
-		if err := rows.Scan(&s.UserID, &s.UserName, &s.AssignedCount); err != nil {
-			return nil, apperror.Wrap(apperror.CodeInternal, "scan reviewer stats", err)
+		if err := rows.Scan(&s.UserID, &s.UserName, &s.AssignedCount, &s.OpenCount, &s.MergedCount); err != nil {
+			return apperror.Wrap(apperror.CodeInternal, "scan reviewer stats", err)
+		}
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "iterate reviewer stats", err)
+	}
+
+	return nil
+}
+
+func (r *statsRepository) GetTeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	const q = `
+		SELECT t.name,
+		       (SELECT COUNT(*)
+		          FROM pull_request_reviewers prr
+		          JOIN pull_requests pr ON pr.id = prr.pull_request_id
+		          JOIN users ru ON ru.id = prr.reviewer_id
+		         WHERE ru.team_name = t.name
+		           AND ($1::timestamptz IS NULL OR pr.created_at >= $1)
+		           AND ($2::timestamptz IS NULL OR pr.created_at < $2)
+		           AND ($3::text = '' OR pr.status = $3)) AS assigned_count,
+		       (SELECT COUNT(*)
+		          FROM pull_requests pr
+		          JOIN users au ON au.id = pr.author_id
+		         WHERE au.team_name = t.name
+		           AND pr.status = 'MERGED'
+		           AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+		           AND ($2::timestamptz IS NULL OR pr.merged_at < $2)) AS merged_count,
+		       COUNT(u.id) AS members_count,
+		       COUNT(u.id) FILTER (WHERE u.is_active) AS active_members
+		FROM teams t
+		LEFT JOIN users u
+		  ON u.team_name = t.name
+		WHERE ($4::text = '' OR t.name = $4)
+		GROUP BY t.name
+		ORDER BY t.name;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.Status, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query team stats", err)
+	}
+	defer rows.Close()
+
+	var res []domain.TeamStats
+
+	for rows.Next() {
+		var s domain.TeamStats
+		if err := rows.Scan(&s.TeamName, &s.AssignedCount, &s.MergedCount, &s.MembersCount, &s.ActiveMembers); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan team stats", err)
 		}
 		res = append(res, s)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, apperror.Wrap(apperror.CodeInternal, "iterate reviewer stats", err)
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate team stats", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetMergeLatencyByTeam(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamLatency, error) {
+	const q = `
+		SELECT au.team_name,
+		       COUNT(*),
+		       percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision)
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		WHERE pr.merged_at IS NOT NULL
+		  AND au.team_name IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+		  AND ($2::timestamptz IS NULL OR pr.merged_at < $2)
+		  AND ($3::text = '' OR au.team_name = $3)
+		GROUP BY au.team_name
+		ORDER BY au.team_name;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query merge latency by team", err)
+	}
+	defer rows.Close()
+
+	var res []domain.TeamLatency
+
+	for rows.Next() {
+		var l domain.TeamLatency
+		if err := rows.Scan(&l.TeamName, &l.Count, &l.P50, &l.P90, &l.P99); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan merge latency by team", err)
+		}
+		res = append(res, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate merge latency by team", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetMergeLatencyByAuthor(ctx context.Context, filter domain.StatsFilter) ([]domain.AuthorLatency, error) {
+	const q = `
+		SELECT au.id, au.name, COALESCE(au.team_name, ''),
+		       COUNT(*),
+		       percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision)
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		WHERE pr.merged_at IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+		  AND ($2::timestamptz IS NULL OR pr.merged_at < $2)
+		  AND ($3::text = '' OR au.team_name = $3)
+		GROUP BY au.id, au.name, au.team_name
+		ORDER BY au.name;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query merge latency by author", err)
+	}
+	defer rows.Close()
+
+	var res []domain.AuthorLatency
+
+	for rows.Next() {
+		var l domain.AuthorLatency
+		if err := rows.Scan(&l.UserID, &l.UserName, &l.TeamName, &l.Count, &l.P50, &l.P90, &l.P99); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan merge latency by author", err)
+		}
+		res = append(res, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate merge latency by author", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetMergeLatencyTrend(ctx context.Context, filter domain.StatsFilter) ([]domain.LatencyTrendBucket, error) {
+	const q = `
+		SELECT date_trunc('week', pr.merged_at) AS week_start,
+		       COUNT(*),
+		       percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision),
+		       percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)::double precision)
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		WHERE pr.merged_at IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+		  AND ($2::timestamptz IS NULL OR pr.merged_at < $2)
+		  AND ($3::text = '' OR au.team_name = $3)
+		GROUP BY week_start
+		ORDER BY week_start;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query merge latency trend", err)
+	}
+	defer rows.Close()
+
+	var res []domain.LatencyTrendBucket
+
+	for rows.Next() {
+		var b domain.LatencyTrendBucket
+		if err := rows.Scan(&b.WeekStart, &b.Count, &b.P50, &b.P90, &b.P99); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan merge latency trend", err)
+		}
+		res = append(res, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate merge latency trend", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetFirstReviewLatency(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerLatency, error) {
+	const q = `
+		SELECT ru.id, ru.name,
+		       COUNT(*),
+		       percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM prr.reviewed_at - prr.assigned_at)::double precision),
+		       percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM prr.reviewed_at - prr.assigned_at)::double precision),
+		       percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM prr.reviewed_at - prr.assigned_at)::double precision)
+		FROM pull_request_reviewers prr
+		JOIN users ru
+		  ON ru.id = prr.reviewer_id
+		WHERE prr.reviewed_at IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR prr.reviewed_at >= $1)
+		  AND ($2::timestamptz IS NULL OR prr.reviewed_at < $2)
+		  AND ($3::text = '' OR ru.team_name = $3)
+		GROUP BY ru.id, ru.name
+		ORDER BY ru.name;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query first review latency", err)
+	}
+	defer rows.Close()
+
+	var res []domain.ReviewerLatency
+
+	for rows.Next() {
+		var l domain.ReviewerLatency
+		if err := rows.Scan(&l.UserID, &l.UserName, &l.Count, &l.P50, &l.P90, &l.P99); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan first review latency", err)
+		}
+		res = append(res, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate first review latency", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetMemberLoads(ctx context.Context, filter domain.StatsFilter) ([]domain.MemberLoad, error) {
+	const q = `
+		SELECT u.id, u.name, u.team_name, u.is_active, COUNT(prr.pull_request_id)
+		FROM users u
+		LEFT JOIN pull_request_reviewers prr
+		  ON prr.reviewer_id = u.id
+		 AND ($1::timestamptz IS NULL OR prr.assigned_at >= $1)
+		 AND ($2::timestamptz IS NULL OR prr.assigned_at < $2)
+		WHERE u.team_name IS NOT NULL
+		  AND ($3::text = '' OR u.team_name = $3)
+		GROUP BY u.id, u.name, u.team_name, u.is_active
+		ORDER BY u.team_name, u.name;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query member loads", err)
+	}
+	defer rows.Close()
+
+	var res []domain.MemberLoad
+
+	for rows.Next() {
+		var m domain.MemberLoad
+		if err := rows.Scan(&m.UserID, &m.UserName, &m.TeamName, &m.IsActive, &m.AssignedCount); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan member load", err)
+		}
+		res = append(res, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate member loads", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) GetActivityChanges(ctx context.Context, filter domain.StatsFilter) ([]domain.ActivityChange, error) {
+	const q = `
+		SELECT l.user_id, l.is_active, l.changed_at
+		FROM user_activity_log l
+		JOIN users u
+		  ON u.id = l.user_id
+		WHERE ($1::timestamptz IS NULL OR l.changed_at < $1)
+		  AND ($2::text = '' OR u.team_name = $2)
+		ORDER BY l.user_id, l.changed_at, l.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query activity changes", err)
+	}
+	defer rows.Close()
+
+	var res []domain.ActivityChange
+
+	for rows.Next() {
+		var c domain.ActivityChange
+		if err := rows.Scan(&c.UserID, &c.IsActive, &c.ChangedAt); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan activity change", err)
+		}
+		res = append(res, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate activity changes", err)
+	}
+
+	return res, nil
+}
+
+func (r *statsRepository) CountOpenPullRequestsByTeam(ctx context.Context) (map[string]int64, error) {
+	const q = `
+		SELECT COALESCE(au.team_name, ''), COUNT(*)
+		FROM pull_requests pr
+		JOIN users au
+		  ON au.id = pr.author_id
+		WHERE pr.status = 'OPEN'
+		GROUP BY au.team_name;
+	`
+
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query open pull requests by team", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]int64)
+
+	for rows.Next() {
+		var (
+			team  string
+			count int64
+		)
+		if err := rows.Scan(&team, &count); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan open pull requests by team", err)
+		}
+		res[team] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate open pull requests by team", err)
 	}
 
 	return res, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
@@ -15,25 +16,30 @@ import (
 type TeamRepository interface {
 	Create(ctx context.Context, team *domain.Team) error
 	GetTeam(ctx context.Context, name string) (*domain.Team, error)
+	// GetTeams возвращает существующие команды из names вместе с участниками одним запросом.
+	GetTeams(ctx context.Context, names []string) ([]domain.Team, error)
+	// ListTeams возвращает первые limit команд по имени вместе с участниками.
+	ListTeams(ctx context.Context, limit int) ([]domain.Team, error)
 }
 
 type teamRepository struct {
-	db *pgxpool.Pool
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
-func NewTeamRepository(db *pgxpool.Pool) TeamRepository {
-	return &teamRepository{db: db}
+func NewTeamRepository(db *pgxpool.Pool, logger *slog.Logger) TeamRepository {
+	return &teamRepository{db: db, logger: logger}
 }
 
 func (r *teamRepository) Create(ctx context.Context, team *domain.Team) (err error) {
-	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
 	}
 
 	defer func() {
 		if err != nil {
-			_ = tx.Rollback(ctx)
+			rollback(ctx, r.logger, tx)
 		}
 	}()
 
@@ -84,7 +90,7 @@ func (r *teamRepository) GetTeam(ctx context.Context, name string) (*domain.Team
 
 	rows, err := r.db.Query(ctx, q, name)
 	if err != nil {
-		return nil, fmt.Errorf("query teams %s: $w", name, err)
+		return nil, fmt.Errorf("query teams %s: %w", name, err)
 	}
 	defer rows.Close()
 
@@ -113,3 +119,83 @@ func (r *teamRepository) GetTeam(ctx context.Context, name string) (*domain.Team
 
 	return &result, nil
 }
+
+func (r *teamRepository) GetTeams(ctx context.Context, names []string) ([]domain.Team, error) {
+	const q = `
+		SELECT t.name, u.id, u.name, u.is_active
+		FROM teams t
+		LEFT JOIN users u
+		  ON u.team_name = t.name
+		WHERE t.name = ANY($1)
+		ORDER BY t.name, u.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, names)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query teams by names", err)
+	}
+
+	return scanTeamsWithMembers(rows)
+}
+
+func (r *teamRepository) ListTeams(ctx context.Context, limit int) ([]domain.Team, error) {
+	const q = `
+		WITH t AS (
+			SELECT name
+			FROM teams
+			ORDER BY name
+			LIMIT $1
+		)
+		SELECT t.name, u.id, u.name, u.is_active
+		FROM t
+		LEFT JOIN users u
+		  ON u.team_name = t.name
+		ORDER BY t.name, u.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, limit)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query teams", err)
+	}
+
+	return scanTeamsWithMembers(rows)
+}
+
+// scanTeamsWithMembers собирает команды из строк (команда, участник), отсортированных
+// по команде. У команды без участников поля участника равны NULL.
+func scanTeamsWithMembers(rows pgx.Rows) ([]domain.Team, error) {
+	defer rows.Close()
+
+	var res []domain.Team
+
+	for rows.Next() {
+		var (
+			teamName string
+			id, name *string
+			isActive *bool
+		)
+		if err := rows.Scan(&teamName, &id, &name, &isActive); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan team member", err)
+		}
+
+		if len(res) == 0 || res[len(res)-1].Name != teamName {
+			res = append(res, domain.Team{Name: teamName, Members: []domain.User{}})
+		}
+		if id == nil {
+			continue
+		}
+
+		team := &res[len(res)-1]
+		team.Members = append(team.Members, domain.User{
+			ID:       *id,
+			Name:     *name,
+			TeamName: teamName,
+			IsActive: *isActive,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate team members", err)
+	}
+
+	return res, nil
+}
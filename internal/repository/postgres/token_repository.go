@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TokenRepository interface {
+	Create(ctx context.Context, token *domain.APIToken, hash []byte) error
+	GetByHash(ctx context.Context, hash []byte) (*domain.APIToken, error)
+	List(ctx context.Context) ([]domain.APIToken, error)
+	Revoke(ctx context.Context, id string) (*domain.APIToken, error)
+	// TouchLastUsed обновляет last_used_at не чаще раза в минуту, чтобы не писать в БД на каждый запрос.
+	TouchLastUsed(ctx context.Context, id string) error
+}
+
+type tokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTokenRepository(db *pgxpool.Pool) TokenRepository {
+	return &tokenRepository{db: db}
+}
+
+const tokenColumns = `id, name, COALESCE(user_id, ''), is_admin, created_at, expires_at, last_used_at, revoked_at`
+
+func scanToken(row pgx.Row) (*domain.APIToken, error) {
+	var t domain.APIToken
+	if err := row.Scan(
+		&t.ID,
+		&t.Name,
+		&t.UserID,
+		&t.IsAdmin,
+		&t.CreatedAt,
+		&t.ExpiresAt,
+		&t.LastUsedAt,
+		&t.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *tokenRepository) Create(ctx context.Context, token *domain.APIToken, hash []byte) error {
+	const q = `
+		INSERT INTO api_tokens (id, name, token_hash, user_id, is_admin, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+		RETURNING created_at;
+	`
+
+	err := conn(ctx, r.db).QueryRow(ctx, q,
+		token.ID,
+		token.Name,
+		hash,
+		token.UserID,
+		token.IsAdmin,
+		token.ExpiresAt,
+	).Scan(&token.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return apperror.New(apperror.CodeNotFound, "user not found")
+		}
+		return apperror.Wrap(apperror.CodeInternal, "insert api token", err)
+	}
+
+	return nil
+}
+
+func (r *tokenRepository) GetByHash(ctx context.Context, hash []byte) (*domain.APIToken, error) {
+	q := `SELECT ` + tokenColumns + ` FROM api_tokens WHERE token_hash = $1`
+
+	t, err := scanToken(r.db.QueryRow(ctx, q, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperror.New(apperror.CodeNotFound, "token not found")
+		}
+		return nil, apperror.Wrap(apperror.CodeInternal, "get api token", err)
+	}
+
+	return t, nil
+}
+
+func (r *tokenRepository) List(ctx context.Context) ([]domain.APIToken, error) {
+	q := `SELECT ` + tokenColumns + ` FROM api_tokens ORDER BY created_at, id`
+
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query api tokens", err)
+	}
+	defer rows.Close()
+
+	res := make([]domain.APIToken, 0)
+	for rows.Next() {
+		t, err := scanToken(rows)
+		if err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan api token", err)
+		}
+		res = append(res, *t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate api tokens", err)
+	}
+
+	return res, nil
+}
+
+func (r *tokenRepository) Revoke(ctx context.Context, id string) (*domain.APIToken, error) {
+	q := `
+		UPDATE api_tokens
+		SET revoked_at = COALESCE(revoked_at, now())
+		WHERE id = $1
+		RETURNING ` + tokenColumns
+
+	t, err := scanToken(conn(ctx, r.db).QueryRow(ctx, q, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperror.New(apperror.CodeNotFound, "token not found")
+		}
+		return nil, apperror.Wrap(apperror.CodeInternal, "revoke api token", err)
+	}
+
+	return t, nil
+}
+
+func (r *tokenRepository) TouchLastUsed(ctx context.Context, id string) error {
+	const q = `
+		UPDATE api_tokens
+		SET last_used_at = now()
+		WHERE id = $1
+		  AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute');
+	`
+
+	if _, err := r.db.Exec(ctx, q, id); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "touch api token", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Transactor выполняет несколько вызовов репозиториев в одной транзакции.
+// Репозитории, получившие контекст из fn, работают в ней же: так изменение и
+// запись о нём в журнал аудита фиксируются или откатываются вместе.
+type Transactor interface {
+	// WithinTx фиксирует транзакцию, если fn вернула nil, иначе откатывает.
+	// Вложенный вызов присоединяется к внешней транзакции.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+type txState struct {
+	tx          pgx.Tx
+	afterCommit []func()
+}
+
+type transactor struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewTransactor(db *pgxpool.Pool, logger *slog.Logger) Transactor {
+	return &transactor{db: db, logger: logger}
+}
+
+func (t *transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "begin tx", err)
+	}
+	defer func() {
+		if err != nil {
+			rollback(ctx, t.logger, tx)
+		}
+	}()
+
+	state := &txState{tx: tx}
+	if err = fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return apperror.Wrap(apperror.CodeInternal, "commit tx", err)
+	}
+
+	for _, f := range state.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit откладывает f до фиксации транзакции из ctx; вне транзакции f
+// выполняется сразу. При откате f не вызывается.
+func AfterCommit(ctx context.Context, f func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, f)
+		return
+	}
+	f()
+}
+
+// querier — общее у *pgxpool.Pool и pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn возвращает транзакцию Transactor из ctx, а без неё — пул.
+func conn(ctx context.Context, db *pgxpool.Pool) querier {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db
+}
+
+// beginTx открывает транзакцию репозитория. Внутри Transactor это точка
+// сохранения общей транзакции: Commit освобождает её, а откат отменяет только
+// изменения репозитория.
+func beginTx(ctx context.Context, db *pgxpool.Pool) (pgx.Tx, error) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx.Begin(ctx)
+	}
+	return db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+// rollback откатывает транзакцию и логирует неудачу: исходную ошибку уже
+// возвращает вызывающий код, а проблема с откатом иначе потерялась бы.
+// Отмену контекста не логируем: pgx в этом случае сам закрывает соединение.
+func rollback(ctx context.Context, logger *slog.Logger, tx pgx.Tx) {
+	if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) && ctx.Err() == nil {
+		logger.WarnContext(ctx, "rollback tx", slog.Any("error", err))
+	}
+}
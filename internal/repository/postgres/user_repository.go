@@ -16,6 +16,12 @@ type UserRepository interface {
 	GetByID(ctx context.Context, userID string) (*domain.User, error)
 	UpdateActiveStatus(ctx context.Context, user *domain.User, isActive bool) error
 	GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error)
+	// GetByIDs возвращает найденных пользователей из ids; отсутствующие пропускаются.
+	GetByIDs(ctx context.Context, ids []string) ([]domain.User, error)
+	// GetReviews возвращает PR, назначенные каждому из userIDs, с полным списком
+	// ревьюверов: не больше limit на пользователя, от новых к старым. Пустой
+	// status не фильтрует.
+	GetReviews(ctx context.Context, userIDs []string, status string, limit int) (map[string][]domain.PullRequest, error)
 }
 
 type userRepository struct {
@@ -81,7 +87,7 @@ func (r *userRepository) UpdateActiveStatus(ctx context.Context, user *domain.Us
 		WHERE id = $1
     `
 
-	_, err := r.db.Exec(ctx, q, user.ID, isActive)
+	_, err := conn(ctx, r.db).Exec(ctx, q, user.ID, isActive)
 	if err != nil {
 		return apperror.Wrap(apperror.CodeInternal, "update user active status", err)
 	}
@@ -132,3 +138,88 @@ func (r *userRepository) GetReview(ctx context.Context, userID string) ([]domain
 
 	return result, nil
 }
+
+func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]domain.User, error) {
+	const q = `
+		SELECT id, name, is_active, COALESCE(team_name, '')
+		FROM users
+		WHERE id = ANY($1)
+		ORDER BY id;
+	`
+
+	rows, err := r.db.Query(ctx, q, ids)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query users by ids", err)
+	}
+	defer rows.Close()
+
+	var res []domain.User
+
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.IsActive, &u.TeamName); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan user", err)
+		}
+		res = append(res, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate users", err)
+	}
+
+	return res, nil
+}
+
+func (r *userRepository) GetReviews(ctx context.Context, userIDs []string, status string, limit int) (map[string][]domain.PullRequest, error) {
+	const q = `
+		SELECT t.reviewer_id, t.id, t.name, t.author_id, t.status, t.created_at, t.merged_at, t.version,
+		       ARRAY(SELECT a.reviewer_id
+		               FROM pull_request_reviewers a
+		              WHERE a.pull_request_id = t.id
+		              ORDER BY a.reviewer_id)
+		FROM (
+			SELECT r.reviewer_id, pr.id, pr.name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version,
+			       row_number() OVER (PARTITION BY r.reviewer_id ORDER BY pr.created_at DESC, pr.id) AS rn
+			FROM pull_request_reviewers r
+			JOIN pull_requests pr
+			  ON pr.id = r.pull_request_id
+			WHERE r.reviewer_id = ANY($1)
+			  AND ($2::text = '' OR pr.status = $2)
+		) t
+		WHERE t.rn <= $3
+		ORDER BY t.reviewer_id, t.rn;
+	`
+
+	rows, err := r.db.Query(ctx, q, userIDs, status, limit)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "query pull requests by reviewers", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]domain.PullRequest, len(userIDs))
+
+	for rows.Next() {
+		var (
+			reviewerID string
+			pr         domain.PullRequest
+		)
+		if err := rows.Scan(
+			&reviewerID,
+			&pr.PullRequestID,
+			&pr.PullRequestName,
+			&pr.AuthorID,
+			&pr.PullRequestStatus,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.Version,
+			&pr.ReviewersID,
+		); err != nil {
+			return nil, apperror.Wrap(apperror.CodeInternal, "scan pull request", err)
+		}
+		res[reviewerID] = append(res[reviewerID], pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "iterate pull requests", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,46 @@
+// Package requestid хранит идентификатор запроса в контексте, чтобы его
+// могли использовать слои ниже HTTP (логи, трассировка).
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header — заголовок, в котором идентификатор принимается и возвращается клиенту.
+const Header = "X-Request-ID"
+
+const maxLen = 128
+
+type ctxKey struct{}
+
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext возвращает идентификатор запроса или пустую строку.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+func New() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Valid проверяет идентификатор, пришедший от клиента: он попадает в логи
+// и заголовки ответа, поэтому допускаются только печатные ASCII-символы без пробелов.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if c := id[i]; c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/requestid"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+const (
+	// DefaultAuditLimit — размер страницы /audit, если limit не задан.
+	DefaultAuditLimit = 50
+	maxAuditLimit     = 500
+)
+
+// Действия журнала аудита.
+const (
+	AuditTeamCreate        = "team.create"
+	AuditUserSetActive     = "user.set_active"
+	AuditPullRequestCreate = "pull_request.create"
+	AuditPullRequestMerge  = "pull_request.merge"
+	AuditReviewerReassign  = "pull_request.reassign"
+	AuditReviewSubmit      = "pull_request.review"
+	AuditTokenCreate       = "token.create"
+	AuditTokenRevoke       = "token.revoke"
+	AuditRoleAssign        = "role.assign"
+	AuditRoleUnassign      = "role.unassign"
+	AuditDataImport        = "data.import"
+	AuditDataRestore       = "data.restore"
+)
+
+type AuditService interface {
+	// Record пишет запись об изменении. Вызывается внутри Transactor.WithinTx
+	// вместе с самим изменением: если запись не удалась, изменение откатывается.
+	Record(ctx context.Context, action, entityType, entityID string, before, after any) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
+
+// AuditListener узнаёт о записях журнала после фиксации транзакции, например
+// чтобы разбудить подписчиков ленты событий.
+type AuditListener interface {
+	AuditRecorded(entry domain.AuditEntry)
+}
+
+type auditService struct {
+	auditRepo postgres.AuditRepository
+	listeners []AuditListener
+	logger    *slog.Logger
+}
+
+func NewAuditService(auditRepo postgres.AuditRepository, logger *slog.Logger, listeners ...AuditListener) AuditService {
+	return &auditService{auditRepo: auditRepo, listeners: listeners, logger: logger}
+}
+
+func (s *auditService) Record(ctx context.Context, action, entityType, entityID string, before, after any) error {
+	beforeJSON, err := snapshotJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := snapshotJSON(after)
+	if err != nil {
+		return err
+	}
+
+	entry := &domain.AuditEntry{
+		Actor:      actorSubject(ctx),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		RequestID:  requestid.FromContext(ctx),
+	}
+
+	if err := s.auditRepo.Record(ctx, entry); err != nil {
+		return err
+	}
+
+	postgres.AfterCommit(ctx, func() {
+		for _, l := range s.listeners {
+			l.AuditRecorded(*entry)
+		}
+	})
+	return nil
+}
+
+func (s *auditService) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	if err := authorize(ctx, domain.PermAuditRead, "read audit log"); err != nil {
+		return nil, err
+	}
+	var v validation.Errors
+	v.Check(filter.Limit >= 0 && filter.Limit <= maxAuditLimit, "limit", validation.RuleRange, "limit must be in 1..500")
+	v.Check(filter.BeforeID >= 0, "cursor", validation.RuleRange, "cursor must be positive")
+	v.Check(filter.From == nil || filter.To == nil || filter.From.Before(*filter.To), "from", validation.RuleRange, "from must be before to")
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultAuditLimit
+	}
+
+	return s.auditRepo.List(ctx, filter)
+}
+
+func snapshotJSON(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeInternal, "marshal audit snapshot", err)
+	}
+	return b, nil
+}
+
+// actorSubject — кем записать вызывающего: без аутентификации — anonymous.
+func actorSubject(ctx context.Context) string {
+	if id := auth.FromContext(ctx); id != nil {
+		return id.Subject
+	}
+	return "anonymous"
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+)
+
+// Снимки сущностей для журнала аудита. Формат полей совпадает с API.
+
+type userSnapshot struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name,omitempty"`
+	IsActive bool   `json:"is_active"`
+}
+
+type teamSnapshot struct {
+	TeamName string         `json:"team_name"`
+	Members  []userSnapshot `json:"members"`
+}
+
+type pullRequestSnapshot struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+}
+
+type tokenSnapshot struct {
+	TokenID   string     `json:"token_id"`
+	Name      string     `json:"name"`
+	UserID    string     `json:"user_id,omitempty"`
+	IsAdmin   bool       `json:"is_admin"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+type roleAssignmentSnapshot struct {
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	TeamName string `json:"team_name,omitempty"`
+}
+
+func snapshotUser(u domain.User) userSnapshot {
+	return userSnapshot{UserID: u.ID, Username: u.Name, TeamName: u.TeamName, IsActive: u.IsActive}
+}
+
+func snapshotTeam(t *domain.Team) teamSnapshot {
+	s := teamSnapshot{TeamName: t.Name, Members: make([]userSnapshot, 0, len(t.Members))}
+	for _, m := range t.Members {
+		m.TeamName = ""
+		s.Members = append(s.Members, snapshotUser(m))
+	}
+	return s
+}
+
+func snapshotPullRequest(pr *domain.PullRequest) pullRequestSnapshot {
+	return pullRequestSnapshot{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		Status:            pr.PullRequestStatus,
+		AssignedReviewers: append([]string{}, pr.ReviewersID...),
+		MergedAt:          pr.MergedAt,
+	}
+}
+
+func snapshotToken(t *domain.APIToken) tokenSnapshot {
+	return tokenSnapshot{
+		TokenID:   t.ID,
+		Name:      t.Name,
+		UserID:    t.UserID,
+		IsAdmin:   t.IsAdmin,
+		ExpiresAt: t.ExpiresAt,
+		RevokedAt: t.RevokedAt,
+	}
+}
+
+func snapshotRoleAssignment(a *domain.RoleAssignment) roleAssignmentSnapshot {
+	return roleAssignmentSnapshot{UserID: a.UserID, Role: a.Role, TeamName: a.TeamName}
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+)
+
+// Проверки прав выполняются в сервисах, а не в обработчиках, чтобы одинаково
+// действовать для HTTP, CLI и будущих транспортов.
+
+func forbidden(action string) error {
+	return apperror.New(apperror.CodeForbidden, "not allowed to "+action)
+}
+
+// authorize требует глобальное право perm.
+func authorize(ctx context.Context, perm domain.Permission, action string) error {
+	if !auth.FromContext(ctx).Can(perm, "") {
+		return forbidden(action)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/backup"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+)
+
+type BackupService interface {
+	Export(ctx context.Context, w io.Writer) error
+	Restore(ctx context.Context, r io.Reader) (*domain.RestoreResult, error)
+}
+
+type backupService struct {
+	backupRepo postgres.BackupRepository
+	tx         postgres.Transactor
+	audit      AuditService
+	logger     *slog.Logger
+}
+
+func NewBackupService(backupRepo postgres.BackupRepository, tx postgres.Transactor, audit AuditService, logger *slog.Logger) BackupService {
+	return &backupService{backupRepo: backupRepo, tx: tx, audit: audit, logger: logger}
+}
+
+func (s *backupService) Export(ctx context.Context, w io.Writer) error {
+	if err := authorize(ctx, domain.PermDataManage, "back up data"); err != nil {
+		return err
+	}
+
+	return s.backupRepo.Snapshot(ctx, func(snap postgres.BackupSnapshot) error {
+		aw := backup.NewWriter(w, time.Now().UTC())
+
+		aw.BeginSection("teams")
+		if err := snap.Teams(ctx, func(name string) error {
+			return aw.Item(backup.Team{Name: name})
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		aw.BeginSection("users")
+		if err := snap.Users(ctx, func(u domain.User) error {
+			item := backup.User{ID: u.ID, Name: u.Name, IsActive: u.IsActive}
+			if u.TeamName != "" {
+				item.TeamName = &u.TeamName
+			}
+			return aw.Item(item)
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		aw.BeginSection("user_roles")
+		if err := snap.UserRoles(ctx, func(a domain.RoleAssignment) error {
+			item := backup.UserRole{UserID: a.UserID, Role: a.Role, CreatedAt: a.CreatedAt}
+			if a.TeamName != "" {
+				item.TeamName = &a.TeamName
+			}
+			return aw.Item(item)
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		aw.BeginSection("pull_requests")
+		if err := snap.PullRequests(ctx, func(pr domain.PullRequest) error {
+			return aw.Item(backup.PullRequest{
+				ID:        pr.PullRequestID,
+				Name:      pr.PullRequestName,
+				AuthorID:  pr.AuthorID,
+				Status:    pr.PullRequestStatus,
+				CreatedAt: pr.CreatedAt,
+				MergedAt:  pr.MergedAt,
+				Version:   pr.Version,
+			})
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		aw.BeginSection("reviewers")
+		if err := snap.Reviewers(ctx, func(a domain.Assignment) error {
+			return aw.Item(backup.Reviewer{
+				PullRequestID: a.PullRequestID,
+				ReviewerID:    a.ReviewerID,
+				AssignedAt:    a.AssignedAt,
+				ReviewedAt:    a.ReviewedAt,
+			})
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		aw.BeginSection("activity_log")
+		if err := snap.ActivityLog(ctx, func(c domain.ActivityChange) error {
+			return aw.Item(backup.ActivityEntry{UserID: c.UserID, IsActive: c.IsActive, ChangedAt: c.ChangedAt})
+		}); err != nil {
+			return err
+		}
+		aw.EndSection()
+
+		return aw.Close()
+	})
+}
+
+func (s *backupService) Restore(ctx context.Context, r io.Reader) (*domain.RestoreResult, error) {
+	if err := authorize(ctx, domain.PermDataManage, "restore data"); err != nil {
+		return nil, err
+	}
+
+	archive, err := backup.Read(r)
+	if err != nil {
+		return nil, apperror.Wrap(apperror.CodeValidation, "invalid backup archive", err)
+	}
+
+	data := &domain.Backup{
+		Teams:        make([]string, 0, len(archive.Teams)),
+		Users:        make([]domain.User, 0, len(archive.Users)),
+		UserRoles:    make([]domain.RoleAssignment, 0, len(archive.UserRoles)),
+		PullRequests: make([]domain.PullRequest, 0, len(archive.PullRequests)),
+		Reviewers:    make([]domain.Assignment, 0, len(archive.Reviewers)),
+		ActivityLog:  make([]domain.ActivityChange, 0, len(archive.ActivityLog)),
+	}
+
+	for _, t := range archive.Teams {
+		data.Teams = append(data.Teams, t.Name)
+	}
+	for _, u := range archive.Users {
+		user := domain.User{ID: u.ID, Name: u.Name, IsActive: u.IsActive}
+		if u.TeamName != nil {
+			user.TeamName = *u.TeamName
+		}
+		data.Users = append(data.Users, user)
+	}
+	for _, a := range archive.UserRoles {
+		assignment := domain.RoleAssignment{UserID: a.UserID, Role: a.Role, CreatedAt: a.CreatedAt}
+		if a.TeamName != nil {
+			assignment.TeamName = *a.TeamName
+		}
+		data.UserRoles = append(data.UserRoles, assignment)
+	}
+	for _, pr := range archive.PullRequests {
+		version := pr.Version
+		if version == 0 {
+			version = 1
+		}
+		data.PullRequests = append(data.PullRequests, domain.PullRequest{
+			PullRequestID:     pr.ID,
+			PullRequestName:   pr.Name,
+			AuthorID:          pr.AuthorID,
+			PullRequestStatus: pr.Status,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			Version:           version,
+		})
+	}
+	for _, rv := range archive.Reviewers {
+		data.Reviewers = append(data.Reviewers, domain.Assignment{
+			PullRequestID: rv.PullRequestID,
+			ReviewerID:    rv.ReviewerID,
+			AssignedAt:    rv.AssignedAt,
+			ReviewedAt:    rv.ReviewedAt,
+		})
+	}
+	for _, c := range archive.ActivityLog {
+		data.ActivityLog = append(data.ActivityLog, domain.ActivityChange{
+			UserID:    c.UserID,
+			IsActive:  c.IsActive,
+			ChangedAt: c.ChangedAt,
+		})
+	}
+
+	res := &domain.RestoreResult{
+		TeamsCount:        len(data.Teams),
+		UsersCount:        len(data.Users),
+		UserRolesCount:    len(data.UserRoles),
+		PullRequestsCount: len(data.PullRequests),
+		ReviewersCount:    len(data.Reviewers),
+		ActivityLogCount:  len(data.ActivityLog),
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.backupRepo.Restore(ctx, data); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditDataRestore, "database", "", nil, map[string]int{
+			"teams":         res.TeamsCount,
+			"users":         res.UsersCount,
+			"user_roles":    res.UserRolesCount,
+			"pull_requests": res.PullRequestsCount,
+			"reviewers":     res.ReviewersCount,
+			"activity_log":  res.ActivityLogCount,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "backup restored",
+		slog.Int("teams", res.TeamsCount),
+		slog.Int("users", res.UsersCount),
+		slog.Int("user_roles", res.UserRolesCount),
+		slog.Int("pull_requests", res.PullRequestsCount),
+		slog.Int("reviewers", res.ReviewersCount),
+		slog.Int("activity_log", res.ActivityLogCount),
+	)
+
+	return res, nil
+}
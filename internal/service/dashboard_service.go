@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+const (
+	// DefaultDashboardLimit — размер списка команд и PR, если limit не задан.
+	DefaultDashboardLimit = 20
+	// MaxDashboardLimit — наибольший limit списков.
+	MaxDashboardLimit = 100
+)
+
+// DashboardService отдаёт данные для дашбордов пачками: каждый метод делает
+// один запрос на весь набор ключей, чтобы GraphQL мог собирать вложенные поля
+// без запроса на каждый объект. Чтение, как и у /team/get и /users/getReview,
+// доступно любому аутентифицированному вызывающему.
+type DashboardService interface {
+	TeamsByNames(ctx context.Context, names []string) ([]domain.Team, error)
+	ListTeams(ctx context.Context, limit int) ([]domain.Team, error)
+	UsersByIDs(ctx context.Context, ids []string) ([]domain.User, error)
+	PullRequestsByIDs(ctx context.Context, ids []string) ([]domain.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
+	// ReviewsByUserIDs возвращает PR, назначенные пользователям, по user_id
+	// ревьювера: не больше limit на пользователя, только в статусе status, если он задан.
+	ReviewsByUserIDs(ctx context.Context, ids []string, status string, limit int) (map[string][]domain.PullRequest, error)
+}
+
+type dashboardService struct {
+	teamRepo postgres.TeamRepository
+	userRepo postgres.UserRepository
+	prRepo   postgres.PullRequestRepository
+}
+
+func NewDashboardService(teamRepo postgres.TeamRepository, userRepo postgres.UserRepository, prRepo postgres.PullRequestRepository) DashboardService {
+	return &dashboardService{teamRepo: teamRepo, userRepo: userRepo, prRepo: prRepo}
+}
+
+func (s *dashboardService) TeamsByNames(ctx context.Context, names []string) ([]domain.Team, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return s.teamRepo.GetTeams(ctx, names)
+}
+
+func (s *dashboardService) ListTeams(ctx context.Context, limit int) ([]domain.Team, error) {
+	limit, err := dashboardLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.teamRepo.ListTeams(ctx, limit)
+}
+
+func (s *dashboardService) UsersByIDs(ctx context.Context, ids []string) ([]domain.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.userRepo.GetByIDs(ctx, ids)
+}
+
+func (s *dashboardService) PullRequestsByIDs(ctx context.Context, ids []string) ([]domain.PullRequest, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.prRepo.GetByIDs(ctx, ids)
+}
+
+func (s *dashboardService) ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error) {
+	if err := dashboardStatus(filter.Status); err != nil {
+		return nil, err
+	}
+
+	limit, err := dashboardLimit(filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = limit
+
+	return s.prRepo.List(ctx, filter)
+}
+
+func (s *dashboardService) ReviewsByUserIDs(ctx context.Context, ids []string, status string, limit int) (map[string][]domain.PullRequest, error) {
+	if err := dashboardStatus(status); err != nil {
+		return nil, err
+	}
+	limit, err := dashboardLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return map[string][]domain.PullRequest{}, nil
+	}
+	return s.userRepo.GetReviews(ctx, ids, status, limit)
+}
+
+func dashboardStatus(status string) error {
+	switch status {
+	case "", string(domain.PRStatusOpen), string(domain.PRStatusMerged):
+		return nil
+	default:
+		return validation.Invalid("status", validation.RuleOneOf, "status must be OPEN or MERGED")
+	}
+}
+
+func dashboardLimit(limit int) (int, error) {
+	if limit < 0 || limit > MaxDashboardLimit {
+		return 0, validation.Invalid("limit", validation.RuleRange, "limit must be in 1..100")
+	}
+	if limit == 0 {
+		return DefaultDashboardLimit, nil
+	}
+	return limit, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+)
+
+type ExportService interface {
+	ExportPullRequests(ctx context.Context, filter domain.StatsFilter, fn func(domain.PullRequest) error) error
+	ExportAssignments(ctx context.Context, filter domain.StatsFilter, fn func(domain.Assignment) error) error
+	ExportReviewerStats(ctx context.Context, filter domain.StatsFilter, fn func(domain.ReviewerStats) error) error
+}
+
+type exportService struct {
+	exportRepo postgres.ExportRepository
+}
+
+func NewExportService(exportRepo postgres.ExportRepository) ExportService {
+	return &exportService{exportRepo: exportRepo}
+}
+
+func (s *exportService) ExportPullRequests(ctx context.Context, filter domain.StatsFilter, fn func(domain.PullRequest) error) error {
+	if err := validateStatsFilter(filter); err != nil {
+		return err
+	}
+
+	return s.exportRepo.StreamPullRequests(ctx, filter, fn)
+}
+
+func (s *exportService) ExportAssignments(ctx context.Context, filter domain.StatsFilter, fn func(domain.Assignment) error) error {
+	if err := validateStatsFilter(filter); err != nil {
+		return err
+	}
+
+	return s.exportRepo.StreamAssignments(ctx, filter, fn)
+}
+
+func (s *exportService) ExportReviewerStats(ctx context.Context, filter domain.StatsFilter, fn func(domain.ReviewerStats) error) error {
+	if err := validateStatsFilter(filter); err != nil {
+		return err
+	}
+
+	return s.exportRepo.StreamReviewerStats(ctx, filter, fn)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+)
+
+const (
+	defaultFairnessWindow = 30 * 24 * time.Hour
+
+	// Порог отклонения фактической нагрузки от ожидаемой, после которого участник считается выбросом.
+	overloadedRatio  = 1.5
+	underloadedRatio = 0.5
+)
+
+// buildTeamFairness считает отчёт по одной команде. Ожидаемая доля участника
+// пропорциональна времени, которое он был активен в окне, поэтому отпуск
+// не делает участника «недогруженным». Участники, неактивные всё окно, не учитываются.
+func buildTeamFairness(teamName string, from, to time.Time, members []domain.MemberLoad, changes map[string][]domain.ActivityChange) domain.TeamFairness {
+	report := domain.TeamFairness{
+		TeamName: teamName,
+		From:     from,
+		To:       to,
+		Members:  make([]domain.MemberFairness, 0, len(members)),
+	}
+
+	window := to.Sub(from)
+	var totalActive float64
+
+	for _, m := range members {
+		activeRatio := activeDuration(m.IsActive, changes[m.UserID], from, to).Seconds() / window.Seconds()
+		if activeRatio == 0 {
+			continue
+		}
+
+		report.TotalAssigned += m.AssignedCount
+		totalActive += activeRatio
+
+		report.Members = append(report.Members, domain.MemberFairness{
+			UserID:        m.UserID,
+			UserName:      m.UserName,
+			AssignedCount: m.AssignedCount,
+			ActiveRatio:   activeRatio,
+		})
+	}
+
+	loads := make([]float64, 0, len(report.Members))
+
+	for i := range report.Members {
+		m := &report.Members[i]
+		m.ExpectedShare = m.ActiveRatio / totalActive
+		if report.TotalAssigned > 0 {
+			m.Share = float64(m.AssignedCount) / float64(report.TotalAssigned)
+			m.LoadRatio = m.Share / m.ExpectedShare
+
+			switch {
+			case m.LoadRatio >= overloadedRatio:
+				m.Outlier = domain.LoadOverloaded
+			case m.LoadRatio <= underloadedRatio:
+				m.Outlier = domain.LoadUnderloaded
+			}
+		}
+
+		// Нагрузка нормируется на время активности, чтобы Джини сравнивал сопоставимые величины.
+		loads = append(loads, float64(m.AssignedCount)/m.ActiveRatio)
+	}
+
+	report.Gini = gini(loads)
+
+	return report
+}
+
+// activeDuration возвращает, сколько пользователь был активен в окне [from, to).
+// changes отсортированы по времени. До первой записи журнала пользователь считается
+// в противоположном ей состоянии, при пустом журнале — в текущем состоянии current.
+func activeDuration(current bool, changes []domain.ActivityChange, from, to time.Time) time.Duration {
+	state := current
+	if len(changes) > 0 {
+		state = !changes[0].IsActive
+	}
+
+	var (
+		total  time.Duration
+		cursor = from
+	)
+
+	for _, c := range changes {
+		if !c.ChangedAt.After(from) {
+			state = c.IsActive
+			continue
+		}
+		if !c.ChangedAt.Before(to) {
+			break
+		}
+		if state {
+			total += c.ChangedAt.Sub(cursor)
+		}
+		cursor = c.ChangedAt
+		state = c.IsActive
+	}
+
+	if state {
+		total += to.Sub(cursor)
+	}
+
+	return total
+}
+
+// gini — коэффициент Джини: 0 — полностью равномерная нагрузка, ближе к 1 — вся нагрузка на одном.
+func gini(values []float64) float64 {
+	n := len(values)
+	if n == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, n)
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += v
+		weighted += float64(i+1) * v
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	g := (2*weighted)/(float64(n)*sum) - float64(n+1)/float64(n)
+
+	return math.Max(0, g)
+}
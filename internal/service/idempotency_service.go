@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+// MaxIdempotencyKeyLength — максимальная длина значения заголовка Idempotency-Key.
+const MaxIdempotencyKeyLength = 255
+
+type IdempotencyService interface {
+	// Begin занимает ключ вызывающего под запрос с хэшем requestHash. Возвращает
+	// сохранённую запись, если запрос с этим ключом уже выполнен и ответ надо
+	// повторить, или nil — тогда запрос выполняется и завершается Complete или Release.
+	Begin(ctx context.Context, key string, requestHash []byte) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error
+	Release(ctx context.Context, key string) error
+	// DeleteExpired удаляет ключи старше TTL и возвращает их количество.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type idempotencyService struct {
+	idempotencyRepo postgres.IdempotencyRepository
+	ttl             time.Duration
+	lockTimeout     time.Duration
+	logger          *slog.Logger
+}
+
+// NewIdempotencyService создаёт сервис ключей идемпотентности. ttl — сколько
+// хранится ответ, lockTimeout — через сколько незавершённый запрос считается зависшим.
+func NewIdempotencyService(idempotencyRepo postgres.IdempotencyRepository, ttl, lockTimeout time.Duration, logger *slog.Logger) IdempotencyService {
+	return &idempotencyService{
+		idempotencyRepo: idempotencyRepo,
+		ttl:             ttl,
+		lockTimeout:     lockTimeout,
+		logger:          logger,
+	}
+}
+
+func (s *idempotencyService) Begin(ctx context.Context, key string, requestHash []byte) (*domain.IdempotencyRecord, error) {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return nil, validation.Invalid("Idempotency-Key", validation.RuleMaxLength,
+			fmt.Sprintf("Idempotency-Key must be 1..%d characters", MaxIdempotencyKeyLength))
+	}
+
+	rec := &domain.IdempotencyRecord{
+		Owner:       actorSubject(ctx),
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(s.ttl),
+	}
+
+	existing, err := s.idempotencyRepo.Acquire(ctx, rec, s.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, nil
+	}
+
+	if !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, apperror.New(apperror.CodeIdempotencyKeyReused,
+			"Idempotency-Key was already used with a different request")
+	}
+	if !existing.Completed() {
+		return nil, apperror.New(apperror.CodeIdempotencyInProgress,
+			"request with this Idempotency-Key is still in progress")
+	}
+
+	s.logger.DebugContext(ctx, "replaying idempotent response",
+		slog.String("idempotency_key", key),
+		slog.Int("status", existing.StatusCode),
+	)
+
+	return existing, nil
+}
+
+func (s *idempotencyService) Complete(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error {
+	return s.idempotencyRepo.Complete(ctx, &domain.IdempotencyRecord{
+		Owner:      actorSubject(ctx),
+		Key:        key,
+		StatusCode: statusCode,
+		Headers:    headers,
+		Body:       body,
+	})
+}
+
+func (s *idempotencyService) Release(ctx context.Context, key string) error {
+	return s.idempotencyRepo.Release(ctx, actorSubject(ctx), key)
+}
+
+func (s *idempotencyService) DeleteExpired(ctx context.Context) (int64, error) {
+	return s.idempotencyRepo.DeleteExpired(ctx)
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+)
+
+type ImportService interface {
+	Import(ctx context.Context, batch *domain.ImportBatch, dryRun bool) (*domain.ImportResult, error)
+}
+
+type importService struct {
+	importRepo postgres.ImportRepository
+	tx         postgres.Transactor
+	audit      AuditService
+	logger     *slog.Logger
+}
+
+func NewImportService(importRepo postgres.ImportRepository, tx postgres.Transactor, audit AuditService, logger *slog.Logger) ImportService {
+	return &importService{importRepo: importRepo, tx: tx, audit: audit, logger: logger}
+}
+
+// Import сначала проверяет весь пакет и возвращает все найденные ошибки строк.
+// Если ошибок нет, пакет загружается одной транзакцией (при dryRun — с откатом).
+func (s *importService) Import(ctx context.Context, batch *domain.ImportBatch, dryRun bool) (*domain.ImportResult, error) {
+	if err := authorize(ctx, domain.PermDataManage, "import data"); err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportResult{DryRun: dryRun}
+
+	errs, err := s.validate(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		s.logger.InfoContext(ctx, "import rejected", slog.Int("row_errors", len(errs)), slog.Bool("dry_run", dryRun))
+		result.Errors = errs
+		return result, nil
+	}
+
+	for _, t := range batch.Teams {
+		result.TeamsCount++
+		result.UsersCount += len(t.Team.Members)
+	}
+	for _, p := range batch.PullRequests {
+		result.PullRequestsCount++
+		result.ReviewersCount += len(p.PullRequest.ReviewersID)
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.importRepo.Apply(ctx, batch, !dryRun); err != nil {
+			return err
+		}
+		if dryRun {
+			return nil
+		}
+		return s.audit.Record(ctx, AuditDataImport, "database", "", nil, map[string]int{
+			"teams":         result.TeamsCount,
+			"users":         result.UsersCount,
+			"pull_requests": result.PullRequestsCount,
+			"reviewers":     result.ReviewersCount,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Applied = !dryRun
+
+	s.logger.InfoContext(ctx, "import finished",
+		slog.Bool("dry_run", dryRun),
+		slog.Int("teams", result.TeamsCount),
+		slog.Int("users", result.UsersCount),
+		slog.Int("pull_requests", result.PullRequestsCount),
+		slog.Int("reviewers", result.ReviewersCount),
+	)
+
+	return result, nil
+}
+
+func (s *importService) validate(ctx context.Context, batch *domain.ImportBatch) ([]domain.ImportRowError, error) {
+	var errs []domain.ImportRowError
+	addErr := func(section string, row int, field, message string) {
+		errs = append(errs, domain.ImportRowError{Section: section, Row: row, Field: field, Message: message})
+	}
+	if batch != nil {
+		errs = append(errs, batch.Errors...)
+	}
+
+	if batch == nil || (len(batch.Teams) == 0 && len(batch.PullRequests) == 0) {
+		addErr("", 0, "", "document contains no teams and no pull requests")
+		return errs, nil
+	}
+
+	var teamNames, userIDs, prIDs []string
+	for _, t := range batch.Teams {
+		teamNames = append(teamNames, t.Team.Name)
+		for _, m := range t.Team.Members {
+			userIDs = append(userIDs, m.ID)
+		}
+	}
+	for _, p := range batch.PullRequests {
+		prIDs = append(prIDs, p.PullRequest.PullRequestID)
+		userIDs = append(userIDs, p.PullRequest.AuthorID)
+		userIDs = append(userIDs, p.PullRequest.ReviewersID...)
+	}
+
+	existingTeams, err := s.importRepo.ExistingTeams(ctx, teamNames)
+	if err != nil {
+		return nil, err
+	}
+	existingUsers, err := s.importRepo.ExistingUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	existingPRs, err := s.importRepo.ExistingPullRequests(ctx, prIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	seenTeams := make(map[string]struct{})
+	knownUsers := make(map[string]struct{})
+	for id := range existingUsers {
+		knownUsers[id] = struct{}{}
+	}
+	seenUsers := make(map[string]struct{})
+
+	for _, t := range batch.Teams {
+		switch _, dup := seenTeams[t.Team.Name]; {
+		case t.Team.Name == "":
+			addErr("teams", t.Row, "team_name", "team_name is required")
+		case dup:
+			addErr("teams", t.Row, "team_name", "duplicate team_name in document")
+		default:
+			if _, exists := existingTeams[t.Team.Name]; exists {
+				addErr("teams", t.Row, "team_name", "team_name already exists")
+			}
+		}
+		seenTeams[t.Team.Name] = struct{}{}
+
+		if len(t.Team.Members) == 0 {
+			addErr("teams", t.Row, "members", "team must contain at least one member")
+		}
+
+		for i, m := range t.Team.Members {
+			section, row, prefix := "teams", t.Row, fmt.Sprintf("members[%d].", i)
+			if i < len(t.MemberRows) {
+				section, row, prefix = "members", t.MemberRows[i], ""
+			}
+
+			if m.ID == "" {
+				addErr(section, row, prefix+"user_id", "user_id is required")
+				continue
+			}
+			if m.Name == "" {
+				addErr(section, row, prefix+"username", "username is required")
+			}
+			if _, dup := seenUsers[m.ID]; dup {
+				addErr(section, row, prefix+"user_id", "user belongs to more than one team in document")
+			}
+			// Импорт создаёт только новые команды, так что существующий
+			// пользователь оказался бы молча перенесён в другую команду.
+			if team, exists := existingUsers[m.ID]; exists {
+				addErr(section, row, prefix+"user_id", fmt.Sprintf("user already exists in team %s", team))
+			}
+			seenUsers[m.ID] = struct{}{}
+			knownUsers[m.ID] = struct{}{}
+		}
+	}
+
+	seenPRs := make(map[string]struct{})
+
+	for _, p := range batch.PullRequests {
+		pr := p.PullRequest
+		row := p.Row
+
+		switch _, dup := seenPRs[pr.PullRequestID]; {
+		case pr.PullRequestID == "":
+			addErr("pull_requests", row, "pull_request_id", "pull_request_id is required")
+		case dup:
+			addErr("pull_requests", row, "pull_request_id", "duplicate pull_request_id in document")
+		default:
+			if _, exists := existingPRs[pr.PullRequestID]; exists {
+				addErr("pull_requests", row, "pull_request_id", "pull_request_id already exists")
+			}
+		}
+		seenPRs[pr.PullRequestID] = struct{}{}
+
+		if pr.PullRequestName == "" {
+			addErr("pull_requests", row, "pull_request_name", "pull_request_name is required")
+		}
+
+		if pr.AuthorID == "" {
+			addErr("pull_requests", row, "author_id", "author_id is required")
+		} else if _, ok := knownUsers[pr.AuthorID]; !ok {
+			addErr("pull_requests", row, "author_id", "author not found")
+		}
+
+		switch pr.PullRequestStatus {
+		case string(domain.PRStatusOpen):
+			if pr.MergedAt != nil {
+				addErr("pull_requests", row, "merged_at", "merged_at is allowed only for MERGED pull requests")
+			}
+		case string(domain.PRStatusMerged):
+			if pr.MergedAt == nil {
+				addErr("pull_requests", row, "merged_at", "merged_at is required for MERGED pull requests")
+			} else if !pr.CreatedAt.IsZero() && pr.MergedAt.Before(pr.CreatedAt) {
+				addErr("pull_requests", row, "merged_at", "merged_at must not be before created_at")
+			}
+		default:
+			addErr("pull_requests", row, "status", "status must be OPEN or MERGED")
+		}
+
+		seenReviewers := make(map[string]struct{})
+		for _, rid := range pr.ReviewersID {
+			switch _, dup := seenReviewers[rid]; {
+			case rid == "":
+				addErr("pull_requests", row, "assigned_reviewers", "reviewer id must not be empty")
+			case rid == pr.AuthorID:
+				addErr("pull_requests", row, "assigned_reviewers", "author cannot be a reviewer")
+			case dup:
+				addErr("pull_requests", row, "assigned_reviewers", fmt.Sprintf("reviewer %s is listed twice", rid))
+			default:
+				if _, ok := knownUsers[rid]; !ok {
+					addErr("pull_requests", row, "assigned_reviewers", fmt.Sprintf("reviewer %s not found", rid))
+				}
+			}
+			seenReviewers[rid] = struct{}{}
+		}
+	}
+
+	return errs, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+)
+
+type jwtAuthenticator struct {
+	verifier *auth.JWTVerifier
+	userRepo postgres.UserRepository
+	roleRepo postgres.RoleRepository
+	logger   *slog.Logger
+}
+
+// NewJWTAuthenticator принимает OIDC-токены SSO. Пользователь из claim должен
+// существовать в users; роли из токена добавляются к ролям из user_roles.
+func NewJWTAuthenticator(
+	verifier *auth.JWTVerifier,
+	userRepo postgres.UserRepository,
+	roleRepo postgres.RoleRepository,
+	logger *slog.Logger,
+) auth.Authenticator {
+	return &jwtAuthenticator{verifier: verifier, userRepo: userRepo, roleRepo: roleRepo, logger: logger}
+}
+
+func (a *jwtAuthenticator) Authenticate(ctx context.Context, raw string) (*auth.Identity, error) {
+	invalid := apperror.New(apperror.CodeUnauthorized, "invalid or expired token")
+
+	claims, err := a.verifier.Verify(ctx, raw)
+	if err != nil {
+		a.logger.DebugContext(ctx, "jwt rejected", slog.Any("error", err))
+		return nil, invalid
+	}
+
+	user, err := a.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		if appErr := apperror.From(err); appErr != nil && appErr.Code == apperror.CodeNotFound {
+			a.logger.DebugContext(ctx, "jwt rejected: unknown user", slog.String("user_id", claims.UserID))
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	grants, err := a.roleRepo.Grants(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(claims.Roles) > 0 {
+		names := make([]string, 0, len(claims.Roles))
+		for _, r := range claims.Roles {
+			names = append(names, r.Role)
+		}
+		perms, err := a.roleRepo.RolePermissions(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range claims.Roles {
+			for _, p := range perms[r.Role] {
+				grants = append(grants, domain.Grant{Permission: p, TeamName: r.TeamName})
+			}
+		}
+	}
+
+	return &auth.Identity{
+		Subject: user.ID,
+		UserID:  user.ID,
+		Grants:  grants,
+	}, nil
+}
@@ -2,31 +2,56 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"math/rand"
 	"time"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/metrics"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
 )
 
+// PullRequestService изменяет PR с учётом версии: expectedVersion — версия из
+// If-Match клиента, 0 — без проверки. Если PR уже другой версии, возвращается CONFLICT.
 type PullRequestService interface {
 	CreatePullRequest(ctx context.Context, id, name, authorID string) (*domain.PullRequest, error)
-	MergePullRequest(ctx context.Context, id string) (*domain.PullRequest, error)
-	ReAssignPullRequest(ctx context.Context, id, oldUserID string) (*domain.PullRequest, string, error)
+	GetPullRequest(ctx context.Context, id string) (*domain.PullRequest, error)
+	MergePullRequest(ctx context.Context, id string, expectedVersion int64) (*domain.PullRequest, error)
+	ReAssignPullRequest(ctx context.Context, id, oldUserID string, expectedVersion int64) (*domain.PullRequest, string, error)
+	MarkReviewed(ctx context.Context, id, reviewerID string, expectedVersion int64) (*domain.PullRequest, error)
 }
 
 type pullRequestService struct {
 	prRepo   postgres.PullRequestRepository
 	userRepo postgres.UserRepository
 	teamRepo postgres.TeamRepository
+	tx       postgres.Transactor
+	audit    AuditService
+	metrics  *metrics.Metrics
+	logger   *slog.Logger
 }
 
-func NewPullRequestService(prRepo postgres.PullRequestRepository, userRepo postgres.UserRepository, teamRepo postgres.TeamRepository) PullRequestService {
+func NewPullRequestService(
+	prRepo postgres.PullRequestRepository,
+	userRepo postgres.UserRepository,
+	teamRepo postgres.TeamRepository,
+	tx postgres.Transactor,
+	audit AuditService,
+	m *metrics.Metrics,
+	logger *slog.Logger,
+) PullRequestService {
 	return &pullRequestService{
 		prRepo:   prRepo,
 		userRepo: userRepo,
 		teamRepo: teamRepo,
+		tx:       tx,
+		audit:    audit,
+		metrics:  m,
+		logger:   logger,
 	}
 }
 
@@ -34,8 +59,17 @@ func (s *pullRequestService) CreatePullRequest(
 	ctx context.Context,
 	id, name, authorID string,
 ) (*domain.PullRequest, error) {
-	if id == "" || name == "" || authorID == "" {
-		return nil, apperror.New(apperror.CodeValidation, "pull_request_id, pull_request_name and author_id are required")
+	var v validation.Errors
+	v.Required("pull_request_id", id)
+	v.Required("pull_request_name", name)
+	v.Required("author_id", authorID)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if actor := auth.FromContext(ctx); !actor.Can(domain.PermPullRequestsManage, "") &&
+		!(actor.Can(domain.PermPullRequestsOwn, "") && actor.Is(authorID)) {
+		return nil, forbidden("create pull requests for another author")
 	}
 
 	author, err := s.userRepo.GetByID(ctx, authorID)
@@ -52,7 +86,7 @@ func (s *pullRequestService) CreatePullRequest(
 		return nil, err
 	}
 
-	reviewersID := s.pickReviewers(team, authorID)
+	reviewersID := s.pickReviewers(ctx, id, team, authorID)
 
 	pr := &domain.PullRequest{
 		PullRequestID:     id,
@@ -62,29 +96,144 @@ func (s *pullRequestService) CreatePullRequest(
 		ReviewersID:       reviewersID,
 	}
 
-	if err := s.prRepo.Create(ctx, pr); err != nil {
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.prRepo.Create(ctx, pr); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditPullRequestCreate, "pull_request", id, nil, snapshotPullRequest(pr))
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	s.metrics.PullRequestCreated()
+	s.logger.InfoContext(ctx, "pull request created",
+		slog.String("pull_request_id", id),
+		slog.String("author_id", authorID),
+		slog.Any("reviewers", reviewersID),
+	)
+
 	return pr, nil
 }
 
-func (s *pullRequestService) MergePullRequest(ctx context.Context, id string) (*domain.PullRequest, error) {
+func (s *pullRequestService) GetPullRequest(ctx context.Context, id string) (*domain.PullRequest, error) {
 	if id == "" {
-		return nil, apperror.New(apperror.CodeValidation, "pull_request_id is required")
+		return nil, validation.Missing("pull_request_id")
+	}
+
+	return s.prRepo.GetByID(ctx, id)
+}
+
+func (s *pullRequestService) MergePullRequest(ctx context.Context, id string, expectedVersion int64) (*domain.PullRequest, error) {
+	if id == "" {
+		return nil, validation.Missing("pull_request_id")
+	}
+
+	var pr *domain.PullRequest
+	err := s.retryConflicts(ctx, "merge", id, expectedVersion, func() error {
+		var err error
+		pr, err = s.merge(ctx, id, expectedVersion)
+		return err
+	})
+	return pr, err
+}
+
+func (s *pullRequestService) merge(ctx context.Context, id string, expectedVersion int64) (*domain.PullRequest, error) {
+	current, err := s.prRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	pr, err := s.prRepo.Merge(ctx, id)
+	if actor := auth.FromContext(ctx); !actor.Can(domain.PermPullRequestsManage, "") &&
+		!(actor.Can(domain.PermPullRequestsOwn, "") && actor.Is(current.AuthorID)) {
+		return nil, forbidden("merge this pull request")
+	}
+	if err := checkVersion(current, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	var (
+		pr     *domain.PullRequest
+		merged bool
+	)
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		// Сливаем ровно прочитанную версию: права и снимок «до» в журнале
+		// относятся к той строке, которая будет изменена.
+		if pr, merged, err = s.prRepo.Merge(ctx, id, current.Version); err != nil {
+			return err
+		}
+		// Повторный merge ничего не меняет и в журнал не попадает. Решает
+		// заблокированная строка, а не чтение до транзакции.
+		if !merged {
+			return nil
+		}
+		return s.audit.Record(ctx, AuditPullRequestMerge, "pull_request", id, snapshotPullRequest(current), snapshotPullRequest(pr))
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Повторный merge метрику не увеличивает: клиенты, повторяющие запрос, не
+	// должны завышать число слитых PR.
+	if merged {
+		s.metrics.PullRequestMerged()
+	}
+	s.logger.InfoContext(ctx, "pull request merged",
+		slog.String("pull_request_id", id),
+		slog.Bool("already_merged", !merged),
+	)
+
 	return pr, nil
 }
 
-func (s *pullRequestService) ReAssignPullRequest(ctx context.Context, prID, oldUserID string) (*domain.PullRequest, string, error) {
-	if prID == "" || oldUserID == "" {
-		return nil, "", apperror.New(apperror.CodeValidation, "pull_request_id and old_user_id are required")
+// updateAttempts — сколько раз изменение PR без If-Match повторяется, если PR
+// изменился между чтением и записью.
+const updateAttempts = 3
+
+// retryConflicts повторяет fn, пока она завершается CONFLICT. С If-Match клиент
+// сам решает, что делать с изменившимся PR, и ошибка возвращается сразу. Без него
+// гонка — внутреннее дело сервиса: fn перечитывает PR и пробует снова.
+func (s *pullRequestService) retryConflicts(ctx context.Context, operation, prID string, expectedVersion int64, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if appErr := apperror.From(err); expectedVersion != 0 || appErr == nil || appErr.Code != apperror.CodeConflict {
+			return err
+		}
+		if attempt == updateAttempts {
+			return apperror.Wrap(apperror.CodeConcurrentUpdate, "pull request is being modified concurrently, retry later", err)
+		}
+
+		s.logger.DebugContext(ctx, "pull request update raced with another one, retrying",
+			slog.String("operation", operation),
+			slog.String("pull_request_id", prID),
+			slog.Int("attempt", attempt),
+		)
+	}
+}
+
+func (s *pullRequestService) ReAssignPullRequest(ctx context.Context, prID, oldUserID string, expectedVersion int64) (*domain.PullRequest, string, error) {
+	var (
+		pr            *domain.PullRequest
+		newReviewerID string
+	)
+	err := s.retryConflicts(ctx, "reassign", prID, expectedVersion, func() error {
+		var err error
+		pr, newReviewerID, err = s.reassign(ctx, prID, oldUserID, expectedVersion)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return pr, newReviewerID, nil
+}
+
+func (s *pullRequestService) reassign(ctx context.Context, prID, oldUserID string, expectedVersion int64) (*domain.PullRequest, string, error) {
+	var v validation.Errors
+	v.Required("pull_request_id", prID)
+	v.Required("old_user_id", oldUserID)
+	if err := v.Err(); err != nil {
+		return nil, "", err
 	}
 
 	pr, err := s.prRepo.GetByID(ctx, prID)
@@ -115,6 +264,18 @@ func (s *pullRequestService) ReAssignPullRequest(ctx context.Context, prID, oldU
 		return nil, "", apperror.New(apperror.CodeValidation, "old reviewer has no teams")
 	}
 
+	// Переназначать может администратор, тимлид команды ревьювера, сам ревьювер или автор PR.
+	actor := auth.FromContext(ctx)
+	if !actor.Can(domain.PermPullRequestsManage, "") &&
+		!actor.Can(domain.PermReassignTeam, oldReviewer.TeamName) &&
+		!(actor.Can(domain.PermReassignSelf, "") && actor.Is(oldUserID)) &&
+		!(actor.Can(domain.PermPullRequestsOwn, "") && actor.Is(pr.AuthorID)) {
+		return nil, "", forbidden("reassign this reviewer")
+	}
+	if err := checkVersion(pr, expectedVersion); err != nil {
+		return nil, "", err
+	}
+
 	team, err := s.teamRepo.GetTeam(ctx, oldReviewer.TeamName)
 	if err != nil {
 		return nil, "", err
@@ -142,7 +303,19 @@ func (s *pullRequestService) ReAssignPullRequest(ctx context.Context, prID, oldU
 		candidates = append(candidates, m.ID)
 	}
 
+	s.logger.DebugContext(ctx, "reassign candidates",
+		slog.String("pull_request_id", prID),
+		slog.String("old_reviewer_id", oldUserID),
+		slog.String("team_name", team.Name),
+		slog.Any("candidates", candidates),
+	)
+
 	if len(candidates) == 0 {
+		s.logger.DebugContext(ctx, "no replacement candidate",
+			slog.String("pull_request_id", prID),
+			slog.String("old_reviewer_id", oldUserID),
+		)
+		s.metrics.NoCandidate("reassign")
 		return nil, "", apperror.New(apperror.CodeNoCandidate, "no active replacement candidate in teams")
 	}
 
@@ -150,15 +323,83 @@ func (s *pullRequestService) ReAssignPullRequest(ctx context.Context, prID, oldU
 	newIdx := r.Intn(len(candidates))
 	newReviewerID := candidates[newIdx]
 
-	updatedPR, err := s.prRepo.ReAssign(ctx, prID, oldUserID, newReviewerID)
+	// Кандидат выбран по прочитанной версии PR: если за это время PR изменился
+	// (например, параллельное переназначение), репозиторий вернёт CONFLICT и
+	// ReAssignPullRequest повторит попытку.
+	var updatedPR *domain.PullRequest
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if updatedPR, err = s.prRepo.ReAssign(ctx, prID, oldUserID, newReviewerID, pr.Version); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditReviewerReassign, "pull_request", prID, snapshotPullRequest(pr), snapshotPullRequest(updatedPR))
+	})
 	if err != nil {
 		return nil, "", err
 	}
 
+	s.metrics.ReviewerReassigned()
+	s.logger.InfoContext(ctx, "reviewer reassigned",
+		slog.String("pull_request_id", prID),
+		slog.String("old_reviewer_id", oldUserID),
+		slog.String("new_reviewer_id", newReviewerID),
+	)
+
 	return updatedPR, newReviewerID, nil
 }
 
-func (s *pullRequestService) pickReviewers(team *domain.Team, authorID string) []string {
+func (s *pullRequestService) MarkReviewed(ctx context.Context, id, reviewerID string, expectedVersion int64) (*domain.PullRequest, error) {
+	var v validation.Errors
+	v.Required("pull_request_id", id)
+	v.Required("reviewer_id", reviewerID)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if actor := auth.FromContext(ctx); !actor.Can(domain.PermPullRequestsManage, "") &&
+		!(actor.Can(domain.PermReviewsSelf, "") && actor.Is(reviewerID)) {
+		return nil, forbidden("mark review of another reviewer")
+	}
+
+	current, err := s.prRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(current, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		marked, err := s.prRepo.MarkReviewed(ctx, id, reviewerID, expectedVersion)
+		if err != nil {
+			return err
+		}
+		// Повторная отметка ничего не меняет и в журнал не попадает.
+		if !marked {
+			return nil
+		}
+		return s.audit.Record(ctx, AuditReviewSubmit, "pull_request", id, nil, map[string]string{
+			"pull_request_id": id,
+			"reviewer_id":     reviewerID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.prRepo.GetByID(ctx, id)
+}
+
+// checkVersion сверяет версию PR с ожидаемой клиентом; 0 — без проверки.
+func checkVersion(pr *domain.PullRequest, expectedVersion int64) error {
+	if expectedVersion != 0 && pr.Version != expectedVersion {
+		return apperror.New(apperror.CodeConflict,
+			fmt.Sprintf("pull request version is %d, expected %d", pr.Version, expectedVersion))
+	}
+	return nil
+}
+
+func (s *pullRequestService) pickReviewers(ctx context.Context, prID string, team *domain.Team, authorID string) []string {
 	var candidates []string
 	for _, m := range team.Members {
 		if !m.IsActive {
@@ -170,16 +411,28 @@ func (s *pullRequestService) pickReviewers(team *domain.Team, authorID string) [
 		candidates = append(candidates, m.ID)
 	}
 
+	var picked []string
 	if len(candidates) <= 2 {
-		out := make([]string, len(candidates))
-		copy(out, candidates)
-		return out
+		picked = make([]string, len(candidates))
+		copy(picked, candidates)
+	} else {
+		shuffled := make([]string, len(candidates))
+		copy(shuffled, candidates)
+
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		r.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		picked = shuffled[:2]
 	}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(candidates), func(i, j int) {
-		candidates[i], candidates[j] = candidates[j], candidates[i]
-	})
+	s.logger.DebugContext(ctx, "reviewers picked",
+		slog.String("pull_request_id", prID),
+		slog.String("author_id", authorID),
+		slog.String("team_name", team.Name),
+		slog.Any("candidates", candidates),
+		slog.Any("reviewers", picked),
+	)
 
-	return candidates[:2]
+	return picked
 }
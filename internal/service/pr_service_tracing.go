@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedPullRequestService оборачивает каждый метод PullRequestService в спан.
+type tracedPullRequestService struct {
+	next   PullRequestService
+	tracer trace.Tracer
+}
+
+func NewTracedPullRequestService(next PullRequestService, tp trace.TracerProvider) PullRequestService {
+	return &tracedPullRequestService{
+		next:   next,
+		tracer: tp.Tracer(tracing.InstrumentationName + "/service"),
+	}
+}
+
+func (s *tracedPullRequestService) CreatePullRequest(ctx context.Context, id, name, authorID string) (*domain.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.CreatePullRequest", trace.WithAttributes(
+		attribute.String("pull_request.id", id),
+		attribute.String("pull_request.author_id", authorID),
+	))
+	defer span.End()
+
+	pr, err := s.next.CreatePullRequest(ctx, id, name, authorID)
+	if err == nil {
+		span.SetAttributes(attribute.StringSlice("pull_request.reviewers", pr.ReviewersID))
+	}
+	endServiceSpan(span, err)
+
+	return pr, err
+}
+
+func (s *tracedPullRequestService) GetPullRequest(ctx context.Context, id string) (*domain.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.GetPullRequest", trace.WithAttributes(
+		attribute.String("pull_request.id", id),
+	))
+	defer span.End()
+
+	pr, err := s.next.GetPullRequest(ctx, id)
+	endServiceSpan(span, err)
+
+	return pr, err
+}
+
+func (s *tracedPullRequestService) MergePullRequest(ctx context.Context, id string, expectedVersion int64) (*domain.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.MergePullRequest", trace.WithAttributes(
+		attribute.String("pull_request.id", id),
+		attribute.Int64("pull_request.expected_version", expectedVersion),
+	))
+	defer span.End()
+
+	pr, err := s.next.MergePullRequest(ctx, id, expectedVersion)
+	endServiceSpan(span, err)
+
+	return pr, err
+}
+
+func (s *tracedPullRequestService) ReAssignPullRequest(ctx context.Context, id, oldUserID string, expectedVersion int64) (*domain.PullRequest, string, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.ReAssignPullRequest", trace.WithAttributes(
+		attribute.String("pull_request.id", id),
+		attribute.String("pull_request.old_reviewer_id", oldUserID),
+		attribute.Int64("pull_request.expected_version", expectedVersion),
+	))
+	defer span.End()
+
+	pr, newReviewerID, err := s.next.ReAssignPullRequest(ctx, id, oldUserID, expectedVersion)
+	if err == nil {
+		span.SetAttributes(attribute.String("pull_request.new_reviewer_id", newReviewerID))
+	}
+	endServiceSpan(span, err)
+
+	return pr, newReviewerID, err
+}
+
+func (s *tracedPullRequestService) MarkReviewed(ctx context.Context, id, reviewerID string, expectedVersion int64) (*domain.PullRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "PullRequestService.MarkReviewed", trace.WithAttributes(
+		attribute.String("pull_request.id", id),
+		attribute.String("pull_request.reviewer_id", reviewerID),
+		attribute.Int64("pull_request.expected_version", expectedVersion),
+	))
+	defer span.End()
+
+	pr, err := s.next.MarkReviewed(ctx, id, reviewerID, expectedVersion)
+	endServiceSpan(span, err)
+
+	return pr, err
+}
+
+// endServiceSpan записывает ошибку в спан. Статус Error ставится только для
+// внутренних ошибок: VALIDATION, NOT_FOUND и т.п. — штатные ответы клиенту.
+func endServiceSpan(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+
+	code := apperror.CodeInternal
+	if appErr := apperror.From(err); appErr != nil {
+		code = appErr.Code
+	}
+	span.SetAttributes(attribute.String("error.code", string(code)))
+	if code == apperror.CodeInternal {
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+)
+
+// reviewEventBatch — сколько записей журнала читается за один вызов Since.
+const reviewEventBatch = 100
+
+// reviewEventActions — действия журнала, из которых строится лента ревью.
+var reviewEventActions = []string{AuditPullRequestCreate, AuditReviewerReassign, AuditPullRequestMerge}
+
+// ReviewEventService отдаёт ленту событий ревью для /events/stream. Источник
+// событий — журнал аудита, который пишется в транзакции самого изменения, поэтому
+// клиент может продолжить ленту с любой позиции без пропусков. Подписчики будятся
+// после фиксации записи; записи других экземпляров сервиса находятся опросом.
+type ReviewEventService interface {
+	// Latest — позиция конца ленты: новые события будут после неё. Записи
+	// транзакций, ещё не видимых ленте, тоже попадут после неё.
+	Latest(ctx context.Context) (domain.ReviewEventCursor, error)
+	// Since возвращает события после cursor, подходящие под filter, и позицию,
+	// с которой читать дальше. Если позиция не сдвинулась, новых событий нет.
+	Since(ctx context.Context, cursor domain.ReviewEventCursor, filter domain.ReviewEventFilter) ([]domain.ReviewEvent, domain.ReviewEventCursor, error)
+	// Subscribe возвращает канал, в который приходит сигнал после новой записи
+	// журнала, и функцию отписки. Канал закрывается при остановке сервиса.
+	Subscribe() (<-chan struct{}, func())
+	// Close закрывает каналы всех подписчиков, чтобы открытые потоки завершились.
+	Close()
+	AuditListener
+}
+
+type reviewEventService struct {
+	auditRepo postgres.AuditRepository
+	userRepo  postgres.UserRepository
+	logger    *slog.Logger
+
+	mu     sync.Mutex
+	subs   map[chan struct{}]struct{}
+	closed bool
+}
+
+// NewReviewEventService создаёт ленту событий. Чтобы подписчики будились без
+// ожидания опроса, сервис передаётся в NewAuditService как AuditListener.
+func NewReviewEventService(auditRepo postgres.AuditRepository, userRepo postgres.UserRepository, logger *slog.Logger) ReviewEventService {
+	return &reviewEventService{
+		auditRepo: auditRepo,
+		userRepo:  userRepo,
+		logger:    logger,
+		subs:      make(map[chan struct{}]struct{}),
+	}
+}
+
+func (s *reviewEventService) Latest(ctx context.Context) (domain.ReviewEventCursor, error) {
+	xmin, err := s.auditRepo.Horizon(ctx)
+	if err != nil {
+		return domain.ReviewEventCursor{}, err
+	}
+	// Все записи транзакций младше xmin уже видны; с xmin начинаются ещё не отданные.
+	return domain.ReviewEventCursor{TxID: xmin - 1, ID: math.MaxInt64, Seq: -1}, nil
+}
+
+func (s *reviewEventService) Since(ctx context.Context, cursor domain.ReviewEventCursor, filter domain.ReviewEventFilter) ([]domain.ReviewEvent, domain.ReviewEventCursor, error) {
+	// Запись, полученную не целиком, читаем снова и отбрасываем уже отданные события.
+	afterID := cursor.ID
+	if cursor.Seq >= 0 {
+		afterID--
+	}
+
+	entries, err := s.auditRepo.ListSince(ctx, cursor.TxID, afterID, reviewEventActions, reviewEventBatch)
+	if err != nil {
+		return nil, cursor, err
+	}
+	if len(entries) == 0 {
+		return nil, cursor, nil
+	}
+
+	var events []domain.ReviewEvent
+	for _, e := range entries {
+		for _, ev := range s.entryEvents(ctx, e) {
+			if ev.TxID == cursor.TxID && ev.ID == cursor.ID && ev.Seq <= cursor.Seq {
+				continue
+			}
+			events = append(events, ev)
+		}
+	}
+
+	if err := s.fillTeams(ctx, events); err != nil {
+		return nil, cursor, err
+	}
+
+	filtered := events[:0]
+	for _, ev := range events {
+		if matchReviewEvent(ev, filter) {
+			filtered = append(filtered, ev)
+		}
+	}
+
+	last := entries[len(entries)-1]
+	next := domain.ReviewEventCursor{TxID: last.TxID, ID: last.ID, Seq: -1}
+	return filtered, next, nil
+}
+
+func (s *reviewEventService) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	s.subs[ch] = struct{}{}
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subs[ch]; ok {
+			delete(s.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (s *reviewEventService) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for ch := range s.subs {
+		delete(s.subs, ch)
+		close(ch)
+	}
+}
+
+// AuditRecorded будит подписчиков после записи PR-события в журнал. Сигнал не
+// блокирует: если подписчик ещё не прочитал прошлый, он и так перечитает ленту.
+func (s *reviewEventService) AuditRecorded(entry domain.AuditEntry) {
+	if !slices.Contains(reviewEventActions, entry.Action) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// entryEvents разворачивает запись журнала в события. Создание PR даёт
+// pr.created и по pr.assigned на каждого назначенного ревьювера.
+func (s *reviewEventService) entryEvents(ctx context.Context, e domain.AuditEntry) []domain.ReviewEvent {
+	var before, after pullRequestSnapshot
+	if err := json.Unmarshal(e.After, &after); err != nil {
+		s.logger.WarnContext(ctx, "review event skipped: bad audit snapshot",
+			slog.Int64("audit_id", e.ID),
+			slog.String("action", e.Action),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+	if e.Before != nil {
+		if err := json.Unmarshal(e.Before, &before); err != nil {
+			s.logger.WarnContext(ctx, "review event skipped: bad audit snapshot",
+				slog.Int64("audit_id", e.ID),
+				slog.String("action", e.Action),
+				slog.Any("error", err),
+			)
+			return nil
+		}
+	}
+
+	base := domain.ReviewEvent{
+		TxID:            e.TxID,
+		ID:              e.ID,
+		OccurredAt:      e.OccurredAt,
+		Actor:           e.Actor,
+		PullRequestID:   after.PullRequestID,
+		PullRequestName: after.PullRequestName,
+		AuthorID:        after.AuthorID,
+		Status:          after.Status,
+		Reviewers:       after.AssignedReviewers,
+	}
+
+	var events []domain.ReviewEvent
+	switch e.Action {
+	case AuditPullRequestCreate:
+		ev := base
+		ev.Type = domain.ReviewEventCreated
+		events = append(events, ev)
+		for _, reviewer := range after.AssignedReviewers {
+			ev := base
+			ev.Type = domain.ReviewEventAssigned
+			ev.ReviewerID = reviewer
+			events = append(events, ev)
+		}
+	case AuditReviewerReassign:
+		ev := base
+		ev.Type = domain.ReviewEventReassigned
+		ev.OldReviewerID = firstMissing(before.AssignedReviewers, after.AssignedReviewers)
+		ev.NewReviewerID = firstMissing(after.AssignedReviewers, before.AssignedReviewers)
+		events = append(events, ev)
+	case AuditPullRequestMerge:
+		ev := base
+		ev.Type = domain.ReviewEventMerged
+		events = append(events, ev)
+	}
+
+	for i := range events {
+		events[i].Seq = i
+	}
+	if len(events) > 0 {
+		events[len(events)-1].Last = true
+	}
+	return events
+}
+
+// fillTeams проставляет событиям команду автора одним запросом на пачку.
+func (s *reviewEventService) fillTeams(ctx context.Context, events []domain.ReviewEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, ev := range events {
+		if !slices.Contains(ids, ev.AuthorID) {
+			ids = append(ids, ev.AuthorID)
+		}
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	teams := make(map[string]string, len(users))
+	for _, u := range users {
+		teams[u.ID] = u.TeamName
+	}
+	for i := range events {
+		events[i].TeamName = teams[events[i].AuthorID]
+	}
+	return nil
+}
+
+func matchReviewEvent(ev domain.ReviewEvent, filter domain.ReviewEventFilter) bool {
+	if filter.TeamName != "" && ev.TeamName != filter.TeamName {
+		return false
+	}
+	if filter.UserID == "" {
+		return true
+	}
+	return ev.AuthorID == filter.UserID ||
+		ev.ReviewerID == filter.UserID ||
+		ev.OldReviewerID == filter.UserID ||
+		ev.NewReviewerID == filter.UserID ||
+		slices.Contains(ev.Reviewers, filter.UserID)
+}
+
+// firstMissing — первый элемент a, которого нет в b.
+func firstMissing(a, b []string) string {
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return v
+		}
+	}
+	return ""
+}
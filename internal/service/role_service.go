@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+type RoleService interface {
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	ListAssignments(ctx context.Context, userID string) ([]domain.RoleAssignment, error)
+	Assign(ctx context.Context, userID, role, teamName string) (*domain.RoleAssignment, error)
+	Unassign(ctx context.Context, userID, role, teamName string) error
+}
+
+type roleService struct {
+	roleRepo postgres.RoleRepository
+	tx       postgres.Transactor
+	audit    AuditService
+	logger   *slog.Logger
+}
+
+func NewRoleService(roleRepo postgres.RoleRepository, tx postgres.Transactor, audit AuditService, logger *slog.Logger) RoleService {
+	return &roleService{roleRepo: roleRepo, tx: tx, audit: audit, logger: logger}
+}
+
+func (s *roleService) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	if err := authorize(ctx, domain.PermRolesManage, "manage roles"); err != nil {
+		return nil, err
+	}
+
+	return s.roleRepo.ListRoles(ctx)
+}
+
+func (s *roleService) ListAssignments(ctx context.Context, userID string) ([]domain.RoleAssignment, error) {
+	if err := authorize(ctx, domain.PermRolesManage, "manage roles"); err != nil {
+		return nil, err
+	}
+
+	return s.roleRepo.ListAssignments(ctx, userID)
+}
+
+func (s *roleService) Assign(ctx context.Context, userID, role, teamName string) (*domain.RoleAssignment, error) {
+	if err := authorize(ctx, domain.PermRolesManage, "manage roles"); err != nil {
+		return nil, err
+	}
+	var v validation.Errors
+	v.Required("user_id", userID)
+	v.Required("role", string(role))
+	v.Check(role != domain.RoleTeamLead || teamName != "", "team_name", validation.RuleRequired, "team_name is required for team_lead")
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	a := &domain.RoleAssignment{UserID: userID, Role: role, TeamName: teamName}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.roleRepo.Assign(ctx, a); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditRoleAssign, "user", userID, nil, snapshotRoleAssignment(a))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "role assigned",
+		slog.String("user_id", userID),
+		slog.String("role", role),
+		slog.String("team_name", teamName),
+	)
+
+	return a, nil
+}
+
+func (s *roleService) Unassign(ctx context.Context, userID, role, teamName string) error {
+	if err := authorize(ctx, domain.PermRolesManage, "manage roles"); err != nil {
+		return err
+	}
+	var v validation.Errors
+	v.Required("user_id", userID)
+	v.Required("role", string(role))
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	a := &domain.RoleAssignment{UserID: userID, Role: role, TeamName: teamName}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.roleRepo.Unassign(ctx, a); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditRoleUnassign, "user", userID, snapshotRoleAssignment(a), nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "role unassigned",
+		slog.String("user_id", userID),
+		slog.String("role", role),
+		slog.String("team_name", teamName),
+	)
+
+	return nil
+}
@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
 )
 
 type StatsService interface {
-	GetReviewerStats(ctx context.Context) ([]domain.ReviewerStats, error)
+	GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error)
+	GetTeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+	GetLatency(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error)
+	GetFairness(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamFairness, error)
 }
 
 type statsService struct {
@@ -19,6 +24,118 @@ func NewStatsService(statsRepo postgres.StatsRepository) StatsService {
 	return &statsService{statsRepo: statsRepo}
 }
 
-func (s *statsService) GetReviewerStats(ctx context.Context) ([]domain.ReviewerStats, error) {
-	return s.statsRepo.GetReviewerStats(ctx)
+func (s *statsService) GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error) {
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return s.statsRepo.GetReviewerStats(ctx, filter)
+}
+
+func (s *statsService) GetTeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return s.statsRepo.GetTeamStats(ctx, filter)
+}
+
+func (s *statsService) GetLatency(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error) {
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+	if filter.Status != "" {
+		return nil, validation.Invalid("status", validation.RuleUnsupported, "status filter is not supported for latency stats")
+	}
+
+	var (
+		report domain.LatencyReport
+		err    error
+	)
+
+	if report.TimeToMergeByTeam, err = s.statsRepo.GetMergeLatencyByTeam(ctx, filter); err != nil {
+		return nil, err
+	}
+	if report.TimeToMergeByAuthor, err = s.statsRepo.GetMergeLatencyByAuthor(ctx, filter); err != nil {
+		return nil, err
+	}
+	if report.TimeToMergeWeeklyTrend, err = s.statsRepo.GetMergeLatencyTrend(ctx, filter); err != nil {
+		return nil, err
+	}
+	if report.TimeToFirstReview, err = s.statsRepo.GetFirstReviewLatency(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func (s *statsService) GetFairness(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamFairness, error) {
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+	if filter.Status != "" {
+		return nil, validation.Invalid("status", validation.RuleUnsupported, "status filter is not supported for fairness stats")
+	}
+
+	to := time.Now().UTC()
+	if filter.To != nil {
+		to = *filter.To
+	}
+	from := to.Add(-defaultFairnessWindow)
+	if filter.From != nil {
+		from = *filter.From
+	}
+	if !from.Before(to) {
+		return nil, validation.Invalid("from", validation.RuleRange, "from must be before to")
+	}
+	filter.From, filter.To = &from, &to
+
+	members, err := s.statsRepo.GetMemberLoads(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.statsRepo.GetActivityChanges(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	changesByUser := make(map[string][]domain.ActivityChange)
+	for _, c := range changes {
+		changesByUser[c.UserID] = append(changesByUser[c.UserID], c)
+	}
+
+	var (
+		res      []domain.TeamFairness
+		teamName string
+		team     []domain.MemberLoad
+	)
+
+	// Участники отсортированы по команде, поэтому группируем их за один проход.
+	for i, m := range members {
+		if i > 0 && m.TeamName != teamName {
+			res = append(res, buildTeamFairness(teamName, from, to, team, changesByUser))
+			team = team[:0]
+		}
+		teamName = m.TeamName
+		team = append(team, m)
+	}
+	if len(team) > 0 {
+		res = append(res, buildTeamFairness(teamName, from, to, team, changesByUser))
+	}
+
+	return res, nil
+}
+
+func validateStatsFilter(filter domain.StatsFilter) error {
+	var v validation.Errors
+	v.Check(filter.From == nil || filter.To == nil || filter.From.Before(*filter.To), "from", validation.RuleRange, "from must be before to")
+
+	switch filter.Status {
+	case "", string(domain.PRStatusOpen), string(domain.PRStatusMerged):
+	default:
+		v.Add("status", validation.RuleOneOf, "status must be OPEN or MERGED")
+	}
+
+	return v.Err()
 }
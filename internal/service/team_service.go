@@ -2,10 +2,11 @@ package service
 
 import (
 	"context"
+	"log/slog"
 
-	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
 )
 
 type TeamService interface {
@@ -15,33 +16,50 @@ type TeamService interface {
 
 type teamService struct {
 	teamRepo postgres.TeamRepository
+	tx       postgres.Transactor
+	audit    AuditService
+	logger   *slog.Logger
 }
 
-func NewTeamService(teamRepo postgres.TeamRepository) TeamService {
-	return &teamService{teamRepo: teamRepo}
+func NewTeamService(teamRepo postgres.TeamRepository, tx postgres.Transactor, audit AuditService, logger *slog.Logger) TeamService {
+	return &teamService{teamRepo: teamRepo, tx: tx, audit: audit, logger: logger}
 }
 
 func (s *teamService) Add(ctx context.Context, team *domain.Team) (*domain.Team, error) {
-	if team == nil {
-		return nil, apperror.New(apperror.CodeValidation, "teams is required")
+	if err := authorize(ctx, domain.PermTeamsManage, "manage teams"); err != nil {
+		return nil, err
 	}
-	if team.Name == "" {
-		return nil, apperror.New(apperror.CodeValidation, "team_name is required")
+	if team == nil {
+		return nil, validation.Missing("team")
 	}
-	if len(team.Members) == 0 {
-		return nil, apperror.New(apperror.CodeValidation, "teams must contain at least one member")
+	var v validation.Errors
+	v.Required("team_name", team.Name)
+	v.Check(len(team.Members) > 0, "members", validation.RuleMinItems, "members must contain at least one member")
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	if err := s.teamRepo.Create(ctx, team); err != nil {
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.teamRepo.Create(ctx, team); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditTeamCreate, "team", team.Name, nil, snapshotTeam(team))
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	s.logger.InfoContext(ctx, "team created",
+		slog.String("team_name", team.Name),
+		slog.Int("members", len(team.Members)),
+	)
+
 	return team, nil
 }
 
 func (s *teamService) GetTeam(ctx context.Context, name string) (*domain.Team, error) {
 	if name == "" {
-		return nil, apperror.New(apperror.CodeValidation, "team_name is required")
+		return nil, validation.Missing("team_name")
 	}
 
 	team, err := s.teamRepo.GetTeam(ctx, name)
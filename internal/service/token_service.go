@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
+)
+
+type TokenService interface {
+	// Create выпускает токен и возвращает его открытое значение — единственный раз.
+	Create(ctx context.Context, name, userID string, isAdmin bool, expiresAt *time.Time) (*domain.APIToken, string, error)
+	List(ctx context.Context) ([]domain.APIToken, error)
+	Revoke(ctx context.Context, id string) (*domain.APIToken, error)
+	auth.Authenticator
+}
+
+type tokenService struct {
+	tokenRepo postgres.TokenRepository
+	roleRepo  postgres.RoleRepository
+	tx        postgres.Transactor
+	audit     AuditService
+	logger    *slog.Logger
+}
+
+func NewTokenService(
+	tokenRepo postgres.TokenRepository,
+	roleRepo postgres.RoleRepository,
+	tx postgres.Transactor,
+	audit AuditService,
+	logger *slog.Logger,
+) TokenService {
+	return &tokenService{tokenRepo: tokenRepo, roleRepo: roleRepo, tx: tx, audit: audit, logger: logger}
+}
+
+func (s *tokenService) Create(
+	ctx context.Context,
+	name, userID string,
+	isAdmin bool,
+	expiresAt *time.Time,
+) (*domain.APIToken, string, error) {
+	if err := authorize(ctx, domain.PermTokensManage, "manage tokens"); err != nil {
+		return nil, "", err
+	}
+	var v validation.Errors
+	v.Required("name", name)
+	v.Check(expiresAt == nil || expiresAt.After(time.Now()), "expires_at", validation.RuleFuture, "expires_at must be in the future")
+	if err := v.Err(); err != nil {
+		return nil, "", err
+	}
+
+	id, raw := auth.NewToken()
+	token := &domain.APIToken{
+		ID:        id,
+		Name:      name,
+		UserID:    userID,
+		IsAdmin:   isAdmin,
+		ExpiresAt: expiresAt,
+	}
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.tokenRepo.Create(ctx, token, auth.HashToken(raw)); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditTokenCreate, "token", token.ID, nil, snapshotToken(token))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.logger.InfoContext(ctx, "api token created",
+		slog.String("token_id", token.ID),
+		slog.String("user_id", token.UserID),
+		slog.Bool("is_admin", token.IsAdmin),
+	)
+
+	return token, raw, nil
+}
+
+func (s *tokenService) List(ctx context.Context) ([]domain.APIToken, error) {
+	if err := authorize(ctx, domain.PermTokensManage, "manage tokens"); err != nil {
+		return nil, err
+	}
+
+	return s.tokenRepo.List(ctx)
+}
+
+func (s *tokenService) Revoke(ctx context.Context, id string) (*domain.APIToken, error) {
+	if err := authorize(ctx, domain.PermTokensManage, "manage tokens"); err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, validation.Missing("token_id")
+	}
+
+	var token *domain.APIToken
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if token, err = s.tokenRepo.Revoke(ctx, id); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditTokenRevoke, "token", token.ID, nil, snapshotToken(token))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "api token revoked", slog.String("token_id", id))
+
+	return token, nil
+}
+
+// Authenticate проверяет токен. Неизвестный, отозванный и просроченный токены
+// неотличимы для клиента, чтобы не раскрывать, какие токены существуют.
+func (s *tokenService) Authenticate(ctx context.Context, raw string) (*auth.Identity, error) {
+	invalid := apperror.New(apperror.CodeUnauthorized, "invalid or expired token")
+
+	token, err := s.tokenRepo.GetByHash(ctx, auth.HashToken(raw))
+	if err != nil {
+		if appErr := apperror.From(err); appErr != nil && appErr.Code == apperror.CodeNotFound {
+			return nil, invalid
+		}
+		return nil, err
+	}
+	if !token.Active(time.Now()) {
+		return nil, invalid
+	}
+
+	if err := s.tokenRepo.TouchLastUsed(ctx, token.ID); err != nil {
+		s.logger.WarnContext(ctx, "update token last_used_at", slog.String("token_id", token.ID), slog.Any("error", err))
+	}
+
+	id := &auth.Identity{
+		Subject: "token:" + token.ID,
+		UserID:  token.UserID,
+		TokenID: token.ID,
+		IsAdmin: token.IsAdmin,
+	}
+
+	// Токен без пользователя получает только права, явно выданные флагом is_admin.
+	if token.UserID != "" {
+		id.Subject = token.UserID
+		if id.Grants, err = s.roleRepo.Grants(ctx, token.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return id, nil
+}
@@ -2,28 +2,45 @@ package service
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/auth"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/domain"
 	"github.com/blumgardt/pr-reviewer-service.git/internal/repository/postgres"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/validation"
 )
 
 type UserService interface {
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
 	GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error)
+	// Me возвращает пользователя, от имени которого выполняется вызов.
+	Me(ctx context.Context) (*domain.User, error)
 }
 
 type userService struct {
 	userRepo postgres.UserRepository
+	tx       postgres.Transactor
+	audit    AuditService
+	logger   *slog.Logger
 }
 
-func NewUserService(userRepo postgres.UserRepository) UserService {
-	return &userService{userRepo: userRepo}
+func NewUserService(userRepo postgres.UserRepository, tx postgres.Transactor, audit AuditService, logger *slog.Logger) UserService {
+	return &userService{userRepo: userRepo, tx: tx, audit: audit, logger: logger}
+}
+
+func (s *userService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	if userID == "" {
+		return nil, validation.Missing("user_id")
+	}
+
+	return s.userRepo.GetByID(ctx, userID)
 }
 
 func (s *userService) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
 	if userID == "" {
-		return nil, apperror.New(apperror.CodeValidation, "user_id is required")
+		return nil, validation.Missing("user_id")
 	}
 
 	user, err := s.userRepo.GetByID(ctx, userID)
@@ -31,17 +48,40 @@ func (s *userService) SetIsActive(ctx context.Context, userID string, isActive b
 		return nil, err
 	}
 
-	if err := s.userRepo.UpdateActiveStatus(ctx, user, isActive); err != nil {
+	// Тимлид управляет активностью только участников своей команды.
+	actor := auth.FromContext(ctx)
+	if !actor.Can(domain.PermUsersManage, "") && !actor.Can(domain.PermUsersManageTeam, user.TeamName) {
+		return nil, forbidden("change activity of this user")
+	}
+
+	before := snapshotUser(*user)
+	after := *user
+	after.IsActive = isActive
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.UpdateActiveStatus(ctx, user, isActive); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, AuditUserSetActive, "user", user.ID, before, snapshotUser(after))
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	s.logger.InfoContext(ctx, "user activity changed",
+		slog.String("user_id", user.ID),
+		slog.String("team_name", user.TeamName),
+		slog.Bool("is_active", isActive),
+	)
+
 	user.IsActive = isActive
+
 	return user, nil
 }
 
 func (s *userService) GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error) {
 	if userID == "" {
-		return nil, apperror.New(apperror.CodeValidation, "user_id is required")
+		return nil, validation.Missing("user_id")
 	}
 
 	pullRequests, err := s.userRepo.GetReview(ctx, userID)
@@ -51,3 +91,15 @@ func (s *userService) GetReview(ctx context.Context, userID string) ([]domain.Pu
 
 	return pullRequests, nil
 }
+
+func (s *userService) Me(ctx context.Context) (*domain.User, error) {
+	actor := auth.FromContext(ctx)
+	if actor == nil {
+		return nil, apperror.New(apperror.CodeUnauthorized, "authentication required")
+	}
+	if actor.UserID == "" {
+		return nil, apperror.New(apperror.CodeValidation, "caller is not bound to a user")
+	}
+
+	return s.userRepo.GetByID(ctx, actor.UserID)
+}
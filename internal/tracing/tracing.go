@@ -0,0 +1,91 @@
+// Package tracing настраивает OpenTelemetry: провайдер спанов с экспортом
+// по OTLP/HTTP или в stdout, W3C trace context и трассировщик запросов pgx.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/buildinfo"
+	"github.com/blumgardt/pr-reviewer-service.git/internal/config"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// InstrumentationName — имя трассировщиков сервиса.
+const InstrumentationName = "github.com/blumgardt/pr-reviewer-service.git"
+
+// Propagator читает и пишет заголовки traceparent/tracestate и baggage.
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// Provider отдаёт трассировщики и сбрасывает накопленные спаны при остановке.
+type Provider struct {
+	trace.TracerProvider
+	shutdown func(ctx context.Context) error
+}
+
+// New создаёт провайдер по конфигурации. При выключенной трассировке
+// возвращается no-op провайдер: спаны не создаются и ничего не экспортируется.
+func New(ctx context.Context, cfg config.TracingConfig) (*Provider, error) {
+	if !cfg.Enabled {
+		return &Provider{
+			TracerProvider: noop.NewTracerProvider(),
+			shutdown:       func(context.Context) error { return nil },
+		}, nil
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.Exporter {
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+	default:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	return NewWithExporter(exporter, cfg, sdktrace.WithBatcher(exporter)), nil
+}
+
+// NewWithExporter создаёт провайдер с готовым экспортёром. Так подключается
+// tracetest.InMemoryExporter при локальной проверке (вместе с sdktrace.WithSyncer).
+func NewWithExporter(exporter sdktrace.SpanExporter, cfg config.TracingConfig, opts ...sdktrace.TracerProviderOption) *Provider {
+	if len(opts) == 0 {
+		opts = []sdktrace.TracerProviderOption{sdktrace.WithSyncer(exporter)}
+	}
+
+	res := resource.NewSchemaless(
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(buildinfo.Get().Version),
+	)
+
+	tp := sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}, opts...)...)
+
+	return &Provider{TracerProvider: tp, shutdown: tp.Shutdown}
+}
+
+// Shutdown отправляет оставшиеся спаны и останавливает экспортёр.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	return p.shutdown(ctx)
+}
@@ -0,0 +1,79 @@
+// Package validation собирает ошибки валидации по полям в apperror.AppError
+// с кодом VALIDATION и списком Details.
+package validation
+
+import (
+	"strings"
+
+	"github.com/blumgardt/pr-reviewer-service.git/internal/apperror"
+)
+
+// Правила, которые попадают в поле rule ошибки.
+const (
+	RuleRequired    = "required"
+	RuleType        = "type"
+	RuleFormat      = "format"
+	RuleOneOf       = "one_of"
+	RuleRange       = "range"
+	RuleMinItems    = "min_items"
+	RuleMaxLength   = "max_length"
+	RuleFuture      = "future"
+	RuleUnsupported = "unsupported"
+)
+
+// Errors накапливает ошибки полей, чтобы вернуть их клиенту разом.
+// Нулевое значение готово к использованию.
+type Errors struct {
+	details []apperror.FieldError
+}
+
+// Add добавляет ошибку поля.
+func (e *Errors) Add(field, rule, message string) {
+	e.details = append(e.details, apperror.FieldError{Field: field, Rule: rule, Message: message})
+}
+
+// Required добавляет ошибку, если значение пустое.
+func (e *Errors) Required(field, value string) {
+	if value == "" {
+		e.Add(field, RuleRequired, field+" is required")
+	}
+}
+
+// Check добавляет ошибку, если условие не выполнено.
+func (e *Errors) Check(ok bool, field, rule, message string) {
+	if !ok {
+		e.Add(field, rule, message)
+	}
+}
+
+// Err возвращает ошибку VALIDATION со всеми накопленными полями или nil.
+func (e *Errors) Err() error {
+	if len(e.details) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(e.details))
+	for _, d := range e.details {
+		messages = append(messages, d.Message)
+	}
+
+	return &apperror.AppError{
+		Code:    apperror.CodeValidation,
+		Message: strings.Join(messages, "; "),
+		Details: e.details,
+	}
+}
+
+// Invalid — ошибка VALIDATION для одного поля.
+func Invalid(field, rule, message string) *apperror.AppError {
+	return &apperror.AppError{
+		Code:    apperror.CodeValidation,
+		Message: message,
+		Details: []apperror.FieldError{{Field: field, Rule: rule, Message: message}},
+	}
+}
+
+// Missing — ошибка VALIDATION для обязательного поля без значения.
+func Missing(field string) *apperror.AppError {
+	return Invalid(field, RuleRequired, field+" is required")
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS pull_requests_merged_at_idx;
+
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS assigned_at;
@@ -0,0 +1,22 @@
+-- Колонка добавляется без значения по умолчанию: иначе всем существующим
+-- назначениям досталось бы время миграции. Для них время назначения неизвестно,
+-- ближайшая оценка — создание PR.
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at timestamptz;
+
+UPDATE pull_request_reviewers r
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.id = r.pull_request_id
+  AND r.assigned_at IS NULL;
+
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN assigned_at SET DEFAULT now(),
+    ALTER COLUMN assigned_at SET NOT NULL;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS reviewed_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS pull_requests_merged_at_idx
+    ON pull_requests (merged_at)
+    WHERE merged_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS users_activity_log_trg ON users;
+DROP FUNCTION IF EXISTS log_user_activity();
+DROP TABLE IF EXISTS user_activity_log;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS user_activity_log (
+    id         bigserial PRIMARY KEY,
+    user_id    text NOT NULL REFERENCES users(id),
+    is_active  boolean NOT NULL,
+    changed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS user_activity_log_user_idx
+    ON user_activity_log (user_id, changed_at);
+
+CREATE OR REPLACE FUNCTION log_user_activity() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.is_active IS DISTINCT FROM OLD.is_active THEN
+        INSERT INTO user_activity_log (user_id, is_active) VALUES (NEW.id, NEW.is_active);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS users_activity_log_trg ON users;
+
+CREATE TRIGGER users_activity_log_trg
+    AFTER INSERT OR UPDATE OF is_active ON users
+    FOR EACH ROW EXECUTE FUNCTION log_user_activity();
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id           text PRIMARY KEY,
+    name         text NOT NULL,
+    token_hash   bytea NOT NULL UNIQUE,
+    user_id      text REFERENCES users(id) ON DELETE CASCADE,
+    is_admin     boolean NOT NULL DEFAULT false,
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    expires_at   timestamptz,
+    last_used_at timestamptz,
+    revoked_at   timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS api_tokens_user_idx ON api_tokens (user_id);
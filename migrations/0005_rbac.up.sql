@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS roles (
+    name        text PRIMARY KEY,
+    description text NOT NULL DEFAULT '',
+    -- роль по умолчанию есть у каждого пользователя без явного назначения
+    is_default  boolean NOT NULL DEFAULT false
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role       text NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission text NOT NULL,
+    PRIMARY KEY (role, permission)
+);
+
+-- team_name ограничивает роль одной командой; NULL — роль действует глобально.
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id    text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role       text NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    team_name  text REFERENCES teams(name) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT user_roles_uniq UNIQUE NULLS NOT DISTINCT (user_id, role, team_name)
+);
+
+CREATE INDEX IF NOT EXISTS user_roles_team_idx ON user_roles (team_name);
+
+INSERT INTO roles (name, description, is_default) VALUES
+    ('admin',     'управление командами, пользователями, PR, токенами и данными', false),
+    ('team_lead', 'деактивация участников и переназначение ревьюверов в своей команде', false),
+    ('member',    'свои PR и собственные назначения', true)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin',     'teams:manage'),
+    ('admin',     'users:manage'),
+    ('admin',     'pull_requests:manage'),
+    ('admin',     'data:manage'),
+    ('admin',     'tokens:manage'),
+    ('admin',     'roles:manage'),
+    ('team_lead', 'users:manage:team'),
+    ('team_lead', 'reviewers:reassign:team'),
+    ('member',    'pull_requests:own'),
+    ('member',    'reviewers:reassign:self'),
+    ('member',    'reviews:self')
+ON CONFLICT DO NOTHING;
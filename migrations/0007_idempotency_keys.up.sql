@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    owner        text NOT NULL,
+    key          text NOT NULL,
+    request_hash bytea NOT NULL,
+    status_code  integer,
+    content_type text NOT NULL DEFAULT '',
+    body         bytea,
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    completed_at timestamptz,
+    expires_at   timestamptz NOT NULL,
+    PRIMARY KEY (owner, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_idx ON idempotency_keys (expires_at);
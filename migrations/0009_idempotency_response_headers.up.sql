@@ -0,0 +1,8 @@
+-- Кроме Content-Type при повторе нужны и другие заголовки ответа (ETag).
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS headers jsonb NOT NULL DEFAULT '{}';
+
+UPDATE idempotency_keys
+SET headers = jsonb_build_object('Content-Type', content_type)
+WHERE content_type <> '';
+
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS content_type;
@@ -0,0 +1,6 @@
+-- Транзакция, в которой сделана запись. id выдаётся при вставке, а не при фиксации,
+-- поэтому лента событий читает журнал в порядке (tx_id, id) и только для транзакций
+-- старше самой старой незавершённой: записей с такими tx_id больше не появится.
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS tx_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX IF NOT EXISTS audit_log_tx_idx ON audit_log (tx_id, id);
@@ -0,0 +1,9 @@
+// Package migrations встраивает SQL-миграции в бинарник.
+package migrations
+
+import "embed"
+
+// FS содержит файлы вида NNNN_name.up.sql и NNNN_name.down.sql.
+//
+//go:embed *.sql
+var FS embed.FS